@@ -6,3 +6,9 @@ provider "zeus" {
 data "zeus_assign" "example" {
   id = "assign-id"
 }
+
+# Find which assign owns slot 4 of a pool
+data "zeus_assign" "by_slot" {
+  pool_id = "pool-id"
+  index   = 4
+}
@@ -0,0 +1,16 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_forward_rules" "edge" {
+  scope_host = "edge-1.example.com"
+}
+
+output "tcp_rules" {
+  value = [
+    for r in data.zeus_forward_rules.edge.rules :
+    "${r.in_port} -> ${r.out_host}:${r.out_port}"
+    if r.proto == "tcp"
+  ]
+}
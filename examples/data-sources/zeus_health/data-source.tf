@@ -0,0 +1,13 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_health" "this" {}
+
+check "zeus_reachable" {
+  assert {
+    condition     = data.zeus_health.this.healthy && data.zeus_health.this.authenticated
+    error_message = "Zeus is not healthy: ${coalesce(data.zeus_health.this.error, "unknown")}"
+  }
+}
@@ -0,0 +1,19 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+# Latest image of a flavor.
+data "zeus_image" "debian" {
+  flavor = "debian"
+}
+
+# A pinned version.
+data "zeus_image" "debian_12" {
+  flavor  = "debian"
+  version = "12"
+}
+
+output "debian_image_url" {
+  value = data.zeus_image.debian.image_url
+}
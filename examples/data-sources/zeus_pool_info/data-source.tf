@@ -0,0 +1,13 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_pool_info" "all" {}
+
+check "region_capacity" {
+  assert {
+    condition     = data.zeus_pool_info.all.regions["us-east-1"].utilization < 90
+    error_message = "Region us-east-1 is more than 90% allocated."
+  }
+}
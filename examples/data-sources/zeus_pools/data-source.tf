@@ -0,0 +1,17 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_pools" "us_east" {
+  region = "us-east-1"
+}
+
+data "zeus_pools" "gateway_pool" {
+  region        = "us-east-1"
+  containing_ip = "10.0.0.1"
+}
+
+output "us_east_pool_ids" {
+  value = data.zeus_pools.us_east.pools[*].id
+}
@@ -0,0 +1,17 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_ports" "vm" {
+  scope_host = "edge-1.example.com"
+  assign_id  = "assign-1"
+}
+
+output "ssh_commands" {
+  value = [
+    for p in data.zeus_ports.vm.ports :
+    "ssh -p ${p.port} root@edge-1.example.com"
+    if p.target_port == 22
+  ]
+}
@@ -0,0 +1,15 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_region" "example" {
+  name = "us-east-1"
+}
+
+resource "zeus_assign" "example" {
+  region = [data.zeus_region.example.id]
+  host   = "host-1"
+  key    = "vm-123"
+  type   = "vm"
+}
@@ -0,0 +1,10 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_regions" "all" {}
+
+output "region_ids_by_name" {
+  value = { for r in data.zeus_regions.all.regions : r.name => r.id }
+}
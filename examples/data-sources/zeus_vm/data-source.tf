@@ -0,0 +1,13 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_vm" "example" {
+  host = "pve-1"
+  vmid = 101
+}
+
+output "vm_address" {
+  value = data.zeus_vm.example.leases["us-east-1"].address
+}
@@ -0,0 +1,14 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+data "zeus_vms" "example" {
+  host = "pve-1"
+}
+
+output "addresses_by_vm" {
+  value = {
+    for vm in data.zeus_vms.example.vms : vm.name => [for lease in try(values(vm.leases), []) : lease.address]
+  }
+}
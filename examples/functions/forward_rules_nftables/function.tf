@@ -0,0 +1,7 @@
+data "zeus_forward_rules" "edge" {
+  scope_host = "edge-1.example.com"
+}
+
+output "nftables_ruleset" {
+  value = provider::zeus::forward_rules_nftables(data.zeus_forward_rules.edge.rules)
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+# Import an existing index-based assign by ID
+terraform import zeus_assign_index.router "assign-id"
@@ -0,0 +1,15 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+resource "zeus_assign_index" "router" {
+  pools = ["pool-v4-id", "pool-v6-id"]
+  index = 10
+  host  = "edge-1"
+  key   = "router-1"
+  type  = "router"
+  data = {
+    role = "anycast"
+  }
+}
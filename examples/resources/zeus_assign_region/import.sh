@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+# Import an existing region address by "assign_id/region"
+terraform import zeus_assign_region.public_v4 "assign-id/public-v4"
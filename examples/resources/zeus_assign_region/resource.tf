@@ -0,0 +1,21 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+resource "zeus_assign" "web" {
+  region = ["private"]
+  host   = "pve-1"
+  key    = "web-1"
+  type   = "vm"
+}
+
+# Layer a public IPv4 address on top of an assign owned by another module
+resource "zeus_assign_region" "public_v4" {
+  assign_id = zeus_assign.web.id
+  region    = "public-v4"
+}
+
+output "public_address" {
+  value = zeus_assign_region.public_v4.address
+}
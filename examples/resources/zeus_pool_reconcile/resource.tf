@@ -0,0 +1,22 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+variable "reconcile_run" {
+  description = "Bump to rebuild the pool allocation state again"
+  type        = string
+  default     = "1"
+}
+
+resource "zeus_pool_reconcile" "primary" {
+  pool_id = "pool-1"
+
+  triggers = {
+    run = var.reconcile_run
+  }
+}
+
+output "reconciled_slots" {
+  value = zeus_pool_reconcile.primary.changed_slots
+}
@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+# Import an existing region by ID
+terraform import zeus_region.example "region-id"
@@ -0,0 +1,16 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+resource "zeus_region" "example" {
+  name          = "us-east-1"
+  friendly_name = "US East"
+}
+
+resource "zeus_pool" "example" {
+  start   = 3232235777 # 192.168.1.1 as integer
+  gateway = 3232236030 # 192.168.1.254 as integer
+  size    = 16
+  region  = zeus_region.example.id
+}
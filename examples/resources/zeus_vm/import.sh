@@ -0,0 +1,3 @@
+#!/usr/bin/env bash
+# Import an existing VM by "host/vmid"
+terraform import zeus_vm.example "pve-1/101"
@@ -0,0 +1,16 @@
+provider "zeus" {
+  endpoint = "http://localhost:8080"
+  token    = "changeme"
+}
+
+resource "zeus_vm" "example" {
+  region = ["us-east-1"]
+  host   = "pve-1"
+  name   = "web-1"
+  vmid   = 101
+  type   = "kvm"
+}
+
+output "vm_address" {
+  value = zeus_vm.example.leases["us-east-1"].address
+}
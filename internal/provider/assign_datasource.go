@@ -9,10 +9,12 @@ import (
 	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 var _ datasource.DataSource = &AssignDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &AssignDataSource{}
 
 func NewAssignDataSource() datasource.DataSource {
 	return &AssignDataSource{}
@@ -24,6 +26,8 @@ type AssignDataSource struct {
 
 type assignDataSourceModel struct {
 	ID        types.String  `tfsdk:"id"`
+	PoolID    types.String  `tfsdk:"pool_id"`
+	Index     types.Int64   `tfsdk:"index"`
 	Key       types.String  `tfsdk:"key"`
 	Type      types.String  `tfsdk:"type"`
 	Data      types.Dynamic `tfsdk:"data"`
@@ -37,11 +41,20 @@ func (d *AssignDataSource) Metadata(ctx context.Context, req datasource.Metadata
 
 func (d *AssignDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Lookup assign info by ID",
+		MarkdownDescription: "Lookup assign info by ID or by pool slot",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "Assign ID",
-				Required:            true,
+				MarkdownDescription: "Assign ID. Set either `id`, or both `pool_id` and `index`.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"pool_id": schema.StringAttribute{
+				MarkdownDescription: "Pool ID to look up the owner of a slot in. Requires `index`.",
+				Optional:            true,
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Slot index within `pool_id`. Requires `pool_id`.",
+				Optional:            true,
 			},
 			"key": schema.StringAttribute{
 				Computed: true,
@@ -50,7 +63,8 @@ func (d *AssignDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 				Computed: true,
 			},
 			"data": schema.DynamicAttribute{
-				Computed: true,
+				MarkdownDescription: "Arbitrary JSON payload. JSON objects and arrays are exposed as objects and tuples, so their elements may have different types.",
+				Computed:            true,
 			},
 			"created_at": schema.StringAttribute{
 				Computed: true,
@@ -63,6 +77,35 @@ func (d *AssignDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 	}
 }
 
+func (d *AssignDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data assignDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.PoolID.IsUnknown() || data.Index.IsUnknown() {
+		return
+	}
+
+	if data.PoolID.IsNull() != data.Index.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pool_id"),
+			"Invalid assign lookup",
+			"pool_id and index must be set together.",
+		)
+		return
+	}
+
+	if data.ID.IsNull() == data.PoolID.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid assign lookup",
+			"Set either id, or both pool_id and index.",
+		)
+	}
+}
+
 func (d *AssignDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -83,12 +126,21 @@ func (d *AssignDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	assign, err := d.client.GetAssign(ctx, data.ID.ValueString())
+	var (
+		assign zeusapi.AssignInfo
+		err    error
+	)
+	if data.ID.IsNull() {
+		assign, err = d.client.GetAssignByIndex(ctx, data.PoolID.ValueString(), data.Index.ValueInt64())
+	} else {
+		assign, err = d.client.GetAssign(ctx, data.ID.ValueString())
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Read assign failed", err.Error())
 		return
 	}
 
+	data.ID = types.StringValue(assign.ID)
 	data.Key = types.StringValue(assign.Key)
 	data.Type = types.StringValue(assign.Type)
 	data.CreatedAt = types.StringValue(assign.CreatedAt)
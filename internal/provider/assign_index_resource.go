@@ -0,0 +1,261 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AssignIndexResource{}
+var _ resource.ResourceWithImportState = &AssignIndexResource{}
+
+func NewAssignIndexResource() resource.Resource {
+	return &AssignIndexResource{}
+}
+
+type AssignIndexResource struct {
+	client *zeusapi.Client
+}
+
+type assignIndexModel struct {
+	ID        types.String  `tfsdk:"id"`
+	Pools     types.List    `tfsdk:"pools"`
+	Index     types.Int64   `tfsdk:"index"`
+	Host      types.String  `tfsdk:"host"`
+	Key       types.String  `tfsdk:"key"`
+	Type      types.String  `tfsdk:"type"`
+	Data      types.Dynamic `tfsdk:"data"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	Leases    types.Map     `tfsdk:"leases"`
+}
+
+func (r *AssignIndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assign_index"
+}
+
+func (r *AssignIndexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Assignment of fixed addresses at a given index in a list of pools",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pools": schema.ListAttribute{
+				MarkdownDescription: "Pool IDs to allocate from",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					requiresReplaceUnlessImportedList(),
+				},
+			},
+			"index": schema.Int64Attribute{
+				MarkdownDescription: "Slot index to allocate in every pool",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host identifier. Zeus does not report it back, so after import it is taken from the configuration.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImportedString(),
+				},
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Business key for idempotency",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type tag",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data": schema.DynamicAttribute{
+				MarkdownDescription: "Arbitrary JSON payload. After import it is taken from the configuration.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Dynamic{
+					requiresReplaceUnlessImportedDynamic(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"leases": schema.MapAttribute{
+				Computed:    true,
+				ElementType: leaseAttrType(),
+			},
+		},
+	}
+}
+
+func (r *AssignIndexResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *AssignIndexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan assignIndexModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var pools []string
+	resp.Diagnostics.Append(plan.Pools.ElementsAs(ctx, &pools, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var data any
+	if !plan.Data.IsNull() {
+		if plan.Data.IsUnknown() {
+			resp.Diagnostics.AddError("Invalid data", "data must be known during apply")
+			return
+		}
+		converted, err := dynamicToJSONCompatible(plan.Data)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid data", err.Error())
+			return
+		}
+		data = converted
+	}
+
+	createResp, err := r.client.CreateAssignByIndex(ctx, zeusapi.AssignCreateIndexRequest{
+		Pools: pools,
+		Index: plan.Index.ValueInt64(),
+		Host:  plan.Host.ValueString(),
+		Key:   plan.Key.ValueString(),
+		Type:  plan.Type.ValueString(),
+		Data:  data,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Create assign by index failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(createResp.ID)
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read assign after create failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AssignIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state assignIndexModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read assign failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only runs after import, to adopt the settings Zeus could not report
+// back; every other change replaces the assign.
+func (r *AssignIndexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan assignIndexModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read assign failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AssignIndexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state assignIndexModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteAssign(ctx, state.ID.ValueString()); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Delete assign failed", err.Error())
+	}
+}
+
+func (r *AssignIndexResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, importedPrivateValue)...)
+}
+
+func (r *AssignIndexResource) refresh(ctx context.Context, m *assignIndexModel) error {
+	assign, err := r.client.GetAssign(ctx, m.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	m.Key = types.StringValue(assign.Key)
+	m.Type = types.StringValue(assign.Type)
+	m.CreatedAt = types.StringValue(assign.CreatedAt)
+
+	m.Leases = encodeLeases(assign.Leases)
+
+	// Imported assigns only have an ID. Their pools and index follow from
+	// the pools that hold the leases.
+	if m.Pools.IsNull() {
+		pools, index, err := leaseSlot(ctx, r.client, assign.Leases)
+		if err != nil {
+			return fmt.Errorf("find pools of assign %s: %w", m.ID.ValueString(), err)
+		}
+		poolValues := make([]attr.Value, 0, len(pools))
+		for _, pool := range pools {
+			poolValues = append(poolValues, types.StringValue(pool))
+		}
+		m.Pools = types.ListValueMust(types.StringType, poolValues)
+		m.Index = types.Int64Value(index)
+	}
+	return nil
+}
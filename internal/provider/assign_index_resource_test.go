@@ -0,0 +1,182 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccAssignIndexResource(t *testing.T) {
+	server := newAssignIndexTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssignIndexConfig(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "id", "assign-index-1"),
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "leases.%", "2"),
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "leases.private.address", "10.0.0.8"),
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "leases.public.address", "203.0.113.8"),
+				),
+			},
+			{
+				ResourceName:      "zeus_assign_index.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"host",
+					"data",
+				},
+			},
+		},
+	})
+}
+
+// TestAccAssignIndexResource_Import checks that the plan after importing an
+// existing assign adopts host and data instead of replacing the assign.
+func TestAccAssignIndexResource_Import(t *testing.T) {
+	server := newAssignIndexTestServer(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccAssignIndexConfig(server.URL),
+				ResourceName:       "zeus_assign_index.test",
+				ImportState:        true,
+				ImportStateId:      "assign-index-1",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					attrs := states[0].Attributes
+					if attrs["pools.0"] != "pool-private" || attrs["pools.1"] != "pool-public" || attrs["index"] != "7" {
+						return fmt.Errorf("unexpected pools or index after import: %v", attrs)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccAssignIndexConfig(server.URL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_assign_index.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "host", "edge-1"),
+					resource.TestCheckResourceAttr("zeus_assign_index.test", "data.role", "anycast"),
+				),
+			},
+			{
+				Config:   testAccAssignIndexConfig(server.URL),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func newAssignIndexTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/assigns/index":
+			var req zeusapi.AssignCreateIndexRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if len(req.Pools) != 2 || req.Pools[0] != "pool-private" || req.Index != 7 || req.Key != "router-1" {
+				http.Error(w, "unexpected request payload", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.AssignCreateIndexResponse{ID: "assign-index-1"})
+		case r.Method == http.MethodGet && r.URL.Path == "/assign/assign-index-1":
+			_ = json.NewEncoder(w).Encode(zeusapi.AssignInfo{
+				ID:        "assign-index-1",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				Key:       "router-1",
+				Type:      "router",
+				Leases: map[string]zeusapi.AddressResult{
+					"private": {
+						Address: "10.0.0.8",
+						Gateway: "10.0.0.254",
+						LeaseID: "lease-private",
+					},
+					"public": {
+						Address: "203.0.113.8",
+						Gateway: "203.0.113.254",
+						LeaseID: "lease-public",
+					},
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/region/private":
+			_ = json.NewEncoder(w).Encode([]zeusapi.PoolDetail{
+				{ID: "pool-private", Region: "private", Begin: "10.0.0.1", End: "10.0.0.200"},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/region/public":
+			_ = json.NewEncoder(w).Encode([]zeusapi.PoolDetail{
+				{ID: "pool-other", Region: "public", Begin: "198.51.100.1", End: "198.51.100.200"},
+				{ID: "pool-public", Region: "public", Begin: "203.0.113.1", End: "203.0.113.200"},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/assign/assign-index-1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func testAccAssignIndexConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_assign_index" "test" {
+  pools = ["pool-private", "pool-public"]
+  index = 7
+  host  = "edge-1"
+  key   = "router-1"
+  type  = "router"
+  data  = { role = "anycast" }
+}
+`
+}
+
+func TestSameElements(t *testing.T) {
+	a := []attr.Value{types.StringValue("pool-a"), types.StringValue("pool-b")}
+	if !sameElements(a, []attr.Value{types.StringValue("pool-b"), types.StringValue("pool-a")}) {
+		t.Error("expected reordered lists to match")
+	}
+	if sameElements(a, []attr.Value{types.StringValue("pool-a"), types.StringValue("pool-a")}) {
+		t.Error("expected lists with different elements not to match")
+	}
+	if sameElements(a, a[:1]) {
+		t.Error("expected lists of different length not to match")
+	}
+}
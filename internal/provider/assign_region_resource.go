@@ -0,0 +1,246 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &AssignRegionResource{}
+var _ resource.ResourceWithImportState = &AssignRegionResource{}
+
+func NewAssignRegionResource() resource.Resource {
+	return &AssignRegionResource{}
+}
+
+type AssignRegionResource struct {
+	client *zeusapi.Client
+}
+
+type assignRegionModel struct {
+	ID       types.String `tfsdk:"id"`
+	AssignID types.String `tfsdk:"assign_id"`
+	Region   types.String `tfsdk:"region"`
+	Host     types.String `tfsdk:"host"`
+	Address  types.String `tfsdk:"address"`
+	Gateway  types.String `tfsdk:"gateway"`
+	LeaseID  types.String `tfsdk:"lease_id"`
+	VLAN     types.Int64  `tfsdk:"vlan"`
+}
+
+func (r *AssignRegionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assign_region"
+}
+
+func (r *AssignRegionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Extra region address attached to an existing assign",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Composite ID in the form `assign_id/region`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"assign_id": schema.StringAttribute{
+				MarkdownDescription: "Assign ID to attach the region to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region to allocate an address in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Host identifier. When omitted Zeus reuses the host of the assign's existing addresses. Zeus does not report it back, so an imported address takes it from the configuration.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					requiresReplaceUnlessImportedString(),
+				},
+			},
+			"address": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway": schema.StringAttribute{
+				Computed: true,
+			},
+			"lease_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"vlan": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *AssignRegionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *AssignRegionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan assignRegionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assignID := plan.AssignID.ValueString()
+	region := plan.Region.ValueString()
+
+	assign, err := r.client.AddAssignRegion(ctx, assignID, zeusapi.AssignRegionRequest{
+		Region: region,
+		Host:   plan.Host.ValueString(),
+	})
+	if err != nil {
+		var apiErr *zeusapi.APIError
+		switch {
+		case errors.As(err, &apiErr) && apiErr.Conflict():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("region"),
+				"Region already assigned",
+				fmt.Sprintf("Assign %s already holds an address in region %q. Import it with the ID %q or remove the region from the resource that owns it: %s", assignID, region, assignID+"/"+region, err),
+			)
+		case errors.As(err, &apiErr) && apiErr.BadRequest() && apiErr.Message == "host is required" && plan.Host.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("host"),
+				"Host is required",
+				fmt.Sprintf("Assign %s has no existing address to reuse a host from, so host must be set: %s", assignID, err),
+			)
+		default:
+			resp.Diagnostics.AddError("Add assign region failed", err.Error())
+		}
+		return
+	}
+
+	plan.ID = types.StringValue(assignID + "/" + region)
+	if !applyAssignRegionLease(&plan, assign) {
+		resp.Diagnostics.AddError(
+			"Add assign region failed",
+			fmt.Sprintf("Zeus accepted the request but assign %s has no lease in region %q", assignID, region),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AssignRegionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state assignRegionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	assign, err := r.client.GetAssign(ctx, state.AssignID.ValueString())
+	if err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read assign region failed", err.Error())
+		return
+	}
+
+	if !applyAssignRegionLease(&state, assign) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AssignRegionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state assignRegionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only host can change in place, right after import; the lease is kept.
+	plan.Address = state.Address
+	plan.Gateway = state.Gateway
+	plan.LeaseID = state.LeaseID
+	plan.VLAN = state.VLAN
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AssignRegionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state assignRegionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.RemoveAssignRegion(ctx, state.AssignID.ValueString(), state.Region.ValueString()); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Delete assign region failed", err.Error())
+	}
+}
+
+func (r *AssignRegionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	assignID, region, ok := strings.Cut(req.ID, "/")
+	if !ok || assignID == "" || region == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID",
+			fmt.Sprintf("expected import ID in the form assign_id/region, got %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("assign_id"), assignID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, importedPrivateValue)...)
+}
+
+func applyAssignRegionLease(m *assignRegionModel, assign zeusapi.AssignInfo) bool {
+	lease, ok := assign.Leases[m.Region.ValueString()]
+	if !ok {
+		return false
+	}
+
+	m.Address = types.StringValue(lease.Address)
+	m.Gateway = types.StringValue(lease.Gateway)
+	m.LeaseID = types.StringValue(lease.LeaseID)
+	if lease.VLAN == nil {
+		m.VLAN = types.Int64Null()
+	} else {
+		m.VLAN = types.Int64Value(*lease.VLAN)
+	}
+	return true
+}
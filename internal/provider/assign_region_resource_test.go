@@ -0,0 +1,238 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAccAssignRegionResource(t *testing.T) {
+	var mu sync.Mutex
+	vlan := int64(100)
+	leases := map[string]zeusapi.AddressResult{
+		"private": {Address: "10.0.0.5", Gateway: "10.0.0.254", LeaseID: "lease-private"},
+	}
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		info := zeusapi.AssignInfo{ID: "assign-1", Key: "vm-1", Type: "vm", Leases: leases}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/assign/assign-1":
+			_ = json.NewEncoder(w).Encode(info)
+		case r.Method == http.MethodPost && r.URL.Path == "/assign/assign-1/region":
+			var req zeusapi.AssignRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Region != "public-v4" || req.Host != "" {
+				http.Error(w, "unexpected request payload", http.StatusBadRequest)
+				return
+			}
+			leases["public-v4"] = zeusapi.AddressResult{
+				Address: "203.0.113.5",
+				Gateway: "203.0.113.1",
+				LeaseID: "lease-public",
+				VLAN:    &vlan,
+			}
+			_ = json.NewEncoder(w).Encode(info)
+		case r.Method == http.MethodDelete && r.URL.Path == "/assign/assign-1/region/public-v4":
+			delete(leases, "public-v4")
+			_ = json.NewEncoder(w).Encode(info)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssignRegionConfig(server.URL, "public-v4"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "id", "assign-1/public-v4"),
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "address", "203.0.113.5"),
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "gateway", "203.0.113.1"),
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "lease_id", "lease-public"),
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "vlan", "100"),
+				),
+			},
+			{
+				ResourceName:      "zeus_assign_region.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAssignRegionResource_ImportWithHost(t *testing.T) {
+	var mu sync.Mutex
+	leases := map[string]zeusapi.AddressResult{
+		"private":   {Address: "10.0.0.5", Gateway: "10.0.0.254", LeaseID: "lease-private"},
+		"public-v4": {Address: "203.0.113.5", Gateway: "203.0.113.1", LeaseID: "lease-public"},
+	}
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		info := zeusapi.AssignInfo{ID: "assign-1", Key: "vm-1", Type: "vm", Leases: leases}
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/assign/assign-1":
+			_ = json.NewEncoder(w).Encode(info)
+		case r.Method == http.MethodDelete && r.URL.Path == "/assign/assign-1/region/public-v4":
+			delete(leases, "public-v4")
+			_ = json.NewEncoder(w).Encode(info)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccAssignRegionWithHostConfig(server.URL, "public-v4", "node-1"),
+				ResourceName:       "zeus_assign_region.test",
+				ImportState:        true,
+				ImportStateId:      "assign-1/public-v4",
+				ImportStatePersist: true,
+			},
+			{
+				Config: testAccAssignRegionWithHostConfig(server.URL, "public-v4", "node-1"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_assign_region.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "host", "node-1"),
+					resource.TestCheckResourceAttr("zeus_assign_region.test", "lease_id", "lease-public"),
+				),
+			},
+			{
+				Config:   testAccAssignRegionWithHostConfig(server.URL, "public-v4", "node-1"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAccAssignRegionWithHostConfig(server.URL, "public-v4", "node-2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_assign_region.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				ExpectError: regexp.MustCompile(`Add assign region failed`),
+			},
+		},
+	})
+}
+
+func TestAccAssignRegionResource_Errors(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/assign/assign-1/region":
+			var req zeusapi.AssignRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Region == "private" {
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "region already assigned"})
+				return
+			}
+			w.WriteHeader(http.StatusBadRequest)
+			switch {
+			case req.Region == "bogus":
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "invalid region"})
+			case req.Host != "":
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "unknown host " + req.Host})
+			default:
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "host is required"})
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAssignRegionConfig(server.URL, "private"),
+				ExpectError: regexp.MustCompile(`Region already assigned`),
+			},
+			{
+				Config:      testAccAssignRegionConfig(server.URL, "public-v4"),
+				ExpectError: regexp.MustCompile(`Host is required`),
+			},
+			{
+				Config:      testAccAssignRegionConfig(server.URL, "bogus"),
+				ExpectError: regexp.MustCompile(`(?s)Add assign region failed.*invalid region`),
+			},
+			{
+				Config:      testAccAssignRegionWithHostConfig(server.URL, "public-v4", "node-9"),
+				ExpectError: regexp.MustCompile(`(?s)Add assign region failed.*unknown host node-9`),
+			},
+		},
+	})
+}
+
+func testAccAssignRegionConfig(endpoint, region string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_assign_region" "test" {
+  assign_id = "assign-1"
+  region    = "` + region + `"
+}
+`
+}
+
+func testAccAssignRegionWithHostConfig(endpoint, region, host string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_assign_region" "test" {
+  assign_id = "assign-1"
+  region    = "` + region + `"
+  host      = "` + host + `"
+}
+`
+}
@@ -6,13 +6,14 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
+	"slices"
 
 	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -55,12 +56,9 @@ func (r *AssignResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"region": schema.ListAttribute{
-				MarkdownDescription: "Regions to allocate in",
+				MarkdownDescription: "Regions to allocate in. Adding or removing a region updates the assign in place and keeps the addresses in the other regions.",
 				Required:            true,
 				ElementType:         types.StringType,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
-				},
 			},
 			"host": schema.StringAttribute{
 				MarkdownDescription: "Host identifier",
@@ -183,9 +181,63 @@ func (r *AssignResource) Read(ctx context.Context, req resource.ReadRequest, res
 }
 
 func (r *AssignResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan assignModel
+	var plan, state assignModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planned, current []string
+	resp.Diagnostics.Append(plan.Region.ElementsAs(ctx, &planned, false)...)
+	resp.Diagnostics.Append(state.Region.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	added, removed := diffRegions(current, planned)
+	id := state.ID.ValueString()
+	applied := current
+
+	// Add new regions before releasing old ones so the assign never ends up
+	// without an address if one of the calls fails halfway through.
+	for _, region := range added {
+		if _, err := r.client.AddAssignRegion(ctx, id, zeusapi.AssignRegionRequest{
+			Region: region,
+			Host:   plan.Host.ValueString(),
+		}); err != nil {
+			resp.Diagnostics.AddError("Add assign region failed", fmt.Sprintf("Region %q: %s", region, err))
+			break
+		}
+		applied = append(applied, region)
+	}
+
+	if !resp.Diagnostics.HasError() {
+		for _, region := range removed {
+			if _, err := r.client.RemoveAssignRegion(ctx, id, region); err != nil {
+				var apiErr *zeusapi.APIError
+				if !errors.As(err, &apiErr) || !apiErr.NotFound() {
+					resp.Diagnostics.AddError("Remove assign region failed", fmt.Sprintf("Region %q: %s", region, err))
+					break
+				}
+			}
+			applied = slices.DeleteFunc(applied, func(v string) bool { return v == region })
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
+		// Record the regions that were actually changed so the next plan
+		// only retries the remaining work.
+		state.Region, _ = types.ListValueFrom(ctx, types.StringType, applied)
+		if err := r.refresh(ctx, &state); err == nil {
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		}
+		return
+	}
+
+	plan.ID = state.ID
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read assign after update failed", err.Error())
 		return
 	}
 
@@ -226,6 +278,21 @@ func (r *AssignResource) refresh(ctx context.Context, m *assignModel) error {
 	return nil
 }
 
+func diffRegions(current, planned []string) ([]string, []string) {
+	var added, removed []string
+	for _, region := range planned {
+		if !slices.Contains(current, region) {
+			added = append(added, region)
+		}
+	}
+	for _, region := range current {
+		if !slices.Contains(planned, region) {
+			removed = append(removed, region)
+		}
+	}
+	return added, removed
+}
+
 func leaseAttrType() types.ObjectType {
 	return types.ObjectType{
 		AttrTypes: map[string]attr.Type{
@@ -5,11 +5,17 @@ package provider
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"regexp"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccAssignResourceAndDataSource(t *testing.T) {
@@ -87,6 +93,197 @@ func TestAccAssignResourceAndDataSource(t *testing.T) {
 	})
 }
 
+func TestAccAssignResource_RegionUpdateInPlace(t *testing.T) {
+	var mu sync.Mutex
+	creates := 0
+	leases := map[string]zeusapi.AddressResult{}
+	addresses := map[string]zeusapi.AddressResult{
+		"us-east-1": {Address: "10.0.0.5", Gateway: "10.0.0.254", LeaseID: "lease-use1"},
+		"eu-west-1": {Address: "10.1.0.5", Gateway: "10.1.0.254", LeaseID: "lease-euw1"},
+	}
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		info := func() zeusapi.AssignInfo {
+			return zeusapi.AssignInfo{
+				ID:        "assign-regions",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				Key:       "vm-1",
+				Type:      "vm",
+				Leases:    leases,
+			}
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/assigns":
+			creates++
+			var req zeusapi.AssignCreateRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			for _, region := range req.Region {
+				leases[region] = addresses[region]
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.AssignCreateResponse{ID: "assign-regions", Addresses: leases})
+		case r.Method == http.MethodGet && r.URL.Path == "/assign/assign-regions":
+			_ = json.NewEncoder(w).Encode(info())
+		case r.Method == http.MethodPost && r.URL.Path == "/assign/assign-regions/region":
+			var req zeusapi.AssignRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if _, ok := leases[req.Region]; ok {
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "region already assigned"})
+				return
+			}
+			leases[req.Region] = addresses[req.Region]
+			_ = json.NewEncoder(w).Encode(info())
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/assign/assign-regions/region/"):
+			delete(leases, strings.TrimPrefix(r.URL.Path, "/assign/assign-regions/region/"))
+			_ = json.NewEncoder(w).Encode(info())
+		case r.Method == http.MethodDelete && r.URL.Path == "/assign/assign-regions":
+			leases = map[string]zeusapi.AddressResult{}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssignRegionsConfig(server.URL, `["us-east-1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.%", "1"),
+				),
+			},
+			{
+				Config: testAccAssignRegionsConfig(server.URL, `["us-east-1", "eu-west-1"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_assign.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign.test", "id", "assign-regions"),
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.%", "2"),
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.us-east-1.lease_id", "lease-use1"),
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.eu-west-1.address", "10.1.0.5"),
+				),
+			},
+			{
+				Config: testAccAssignRegionsConfig(server.URL, `["eu-west-1"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_assign.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.%", "1"),
+					resource.TestCheckResourceAttr("zeus_assign.test", "leases.eu-west-1.lease_id", "lease-euw1"),
+					resource.TestCheckNoResourceAttr("zeus_assign.test", "leases.us-east-1.address"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if creates != 1 {
+							return fmt.Errorf("expected assign to be created once, got %d creates", creates)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssignDataSource_ByPoolIndex(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/assigns/index":
+			if r.URL.Query().Get("pool") != "pool-1" || r.URL.Query().Get("index") != "4" {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "assign not found"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.AssignInfo{
+				ID:        "assign-9",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				Key:       "vm-9",
+				Type:      "vm",
+				Data: map[string]any{
+					"tag":   "blue",
+					"ports": []any{"ssh", 22},
+					"owner": map[string]any{"team": "infra", "oncall": true},
+				},
+				Leases: map[string]zeusapi.AddressResult{
+					"us-east-1": {
+						Address: "10.0.0.5",
+						Gateway: "10.0.0.254",
+						LeaseID: "lease-9",
+					},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssignByPoolIndexConfig(server.URL, `
+  pool_id = "pool-1"
+`),
+				ExpectError: regexp.MustCompile(`pool_id and index must be set together`),
+			},
+			{
+				Config: testAccAssignByPoolIndexConfig(server.URL, `
+  id      = "assign-9"
+  pool_id = "pool-1"
+  index   = 4
+`),
+				ExpectError: regexp.MustCompile(`Set either id, or both pool_id and index`),
+			},
+			{
+				Config: testAccAssignByPoolIndexConfig(server.URL, `
+  pool_id = "pool-1"
+  index   = 4
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "id", "assign-9"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "key", "vm-9"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "leases.us-east-1.address", "10.0.0.5"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.tag", "blue"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.ports.#", "2"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.ports.0", "ssh"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.ports.1", "22"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.owner.team", "infra"),
+					resource.TestCheckResourceAttr("data.zeus_assign.slot", "data.owner.oncall", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccAssignConfig(endpoint string) string {
 	return `
 provider "zeus" {
@@ -107,3 +304,30 @@ data "zeus_assign" "by_id" {
 }
 `
 }
+
+func testAccAssignByPoolIndexConfig(endpoint, lookup string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_assign" "slot" {` + lookup + `}
+`
+}
+
+func testAccAssignRegionsConfig(endpoint, regions string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_assign" "test" {
+  region = ` + regions + `
+  host   = "host-1"
+  key    = "vm-1"
+  type   = "vm"
+}
+`
+}
@@ -9,14 +9,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 )
 
-type requiresReplaceDynamicModifier struct{}
+type requiresReplaceDynamicModifier struct {
+	// adoptAfterImport fills a null value left by import in from the
+	// configuration instead of replacing the resource.
+	adoptAfterImport bool
+}
 
 func (m requiresReplaceDynamicModifier) Description(ctx context.Context) string {
+	if m.adoptAfterImport {
+		return adoptAfterImportDescription
+	}
 	return "requires resource replacement if the value changes"
 }
 
 func (m requiresReplaceDynamicModifier) MarkdownDescription(ctx context.Context) string {
-	return "requires resource replacement if the value changes"
+	return m.Description(ctx)
 }
 
 func (m requiresReplaceDynamicModifier) PlanModifyDynamic(ctx context.Context, req planmodifier.DynamicRequest, resp *planmodifier.DynamicResponse) {
@@ -24,11 +31,26 @@ func (m requiresReplaceDynamicModifier) PlanModifyDynamic(ctx context.Context, r
 		return
 	}
 
-	if !req.PlanValue.Equal(req.StateValue) {
-		resp.RequiresReplace = true
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	if m.adoptAfterImport && req.StateValue.IsNull() && !req.State.Raw.IsNull() {
+		imported, diags := wasImported(ctx, req.Private)
+		resp.Diagnostics.Append(diags...)
+		if imported {
+			return
+		}
 	}
+	resp.RequiresReplace = true
 }
 
 func requiresReplaceDynamic() planmodifier.Dynamic {
 	return requiresReplaceDynamicModifier{}
 }
+
+// requiresReplaceUnlessImportedDynamic is requiresReplaceDynamic, except that
+// a null value left by import is filled in from the configuration.
+func requiresReplaceUnlessImportedDynamic() planmodifier.Dynamic {
+	return requiresReplaceDynamicModifier{adoptAfterImport: true}
+}
@@ -0,0 +1,113 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ForwardRulesDataSource{}
+
+func NewForwardRulesDataSource() datasource.DataSource {
+	return &ForwardRulesDataSource{}
+}
+
+type ForwardRulesDataSource struct {
+	client *zeusapi.Client
+}
+
+type forwardRulesDataSourceModel struct {
+	ScopeHost types.String      `tfsdk:"scope_host"`
+	Rules     []forwardRuleItem `tfsdk:"rules"`
+}
+
+type forwardRuleItem struct {
+	InPort  types.Int64  `tfsdk:"in_port"`
+	OutPort types.Int64  `tfsdk:"out_port"`
+	OutHost types.String `tfsdk:"out_host"`
+	Proto   types.String `tfsdk:"proto"`
+}
+
+func (d *ForwardRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_forward_rules"
+}
+
+func (d *ForwardRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Port forwarding rule table of a scope host",
+		Attributes: map[string]schema.Attribute{
+			"scope_host": schema.StringAttribute{
+				MarkdownDescription: "Port scope host",
+				Required:            true,
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"in_port": schema.Int64Attribute{
+							MarkdownDescription: "Port exposed on the scope host",
+							Computed:            true,
+						},
+						"out_port": schema.Int64Attribute{
+							MarkdownDescription: "Destination port",
+							Computed:            true,
+						},
+						"out_host": schema.StringAttribute{
+							MarkdownDescription: "Destination address",
+							Computed:            true,
+						},
+						"proto": schema.StringAttribute{
+							MarkdownDescription: "`tcp` or `udp`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ForwardRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *ForwardRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data forwardRulesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := d.client.ListForwardRules(ctx, data.ScopeHost.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("List forward rules failed", err.Error())
+		return
+	}
+
+	data.Rules = make([]forwardRuleItem, 0, len(rules))
+	for _, rule := range rules {
+		data.Rules = append(data.Rules, forwardRuleItem{
+			InPort:  types.Int64Value(rule.InPort),
+			OutPort: types.Int64Value(rule.OutPort),
+			OutHost: types.StringValue(rule.OutHost),
+			Proto:   types.StringValue(rule.Proto),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
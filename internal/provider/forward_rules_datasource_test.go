@@ -0,0 +1,60 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccForwardRulesDataSource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ports/node-1":
+			_ = json.NewEncoder(w).Encode([]zeusapi.ForwardRule{
+				{InPort: 32022, OutPort: 22, OutHost: "10.0.0.5", Proto: "tcp"},
+				{InPort: 32053, OutPort: 53, OutHost: "10.0.0.6", Proto: "udp"},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccForwardRulesConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_forward_rules.edge", "rules.#", "2"),
+				resource.TestCheckResourceAttr("data.zeus_forward_rules.edge", "rules.0.in_port", "32022"),
+				resource.TestCheckResourceAttr("data.zeus_forward_rules.edge", "rules.0.out_port", "22"),
+				resource.TestCheckResourceAttr("data.zeus_forward_rules.edge", "rules.0.out_host", "10.0.0.5"),
+				resource.TestCheckResourceAttr("data.zeus_forward_rules.edge", "rules.1.proto", "udp"),
+			),
+		}},
+	})
+}
+
+func testAccForwardRulesConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_forward_rules" "edge" {
+  scope_host = "node-1"
+}
+`
+}
@@ -0,0 +1,212 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// forwardRuleArg is one element of the rules argument. The attribute names
+// match the rules of the zeus_forward_rules data source so its output can be
+// passed in as is.
+type forwardRuleArg struct {
+	InPort  int64  `tfsdk:"in_port"`
+	OutPort int64  `tfsdk:"out_port"`
+	OutHost string `tfsdk:"out_host"`
+	Proto   string `tfsdk:"proto"`
+}
+
+func forwardRuleAttrType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"in_port":  types.Int64Type,
+			"out_port": types.Int64Type,
+			"out_host": types.StringType,
+			"proto":    types.StringType,
+		},
+	}
+}
+
+func forwardRulesParameter() function.ListParameter {
+	return function.ListParameter{
+		Name:        "rules",
+		Description: "Forward rules as returned by the zeus_forward_rules data source: objects with in_port, out_port, out_host and proto",
+		ElementType: forwardRuleAttrType(),
+	}
+}
+
+var _ function.Function = &ForwardRulesNftablesFunction{}
+
+func NewForwardRulesNftablesFunction() function.Function {
+	return &ForwardRulesNftablesFunction{}
+}
+
+type ForwardRulesNftablesFunction struct{}
+
+func (f *ForwardRulesNftablesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "forward_rules_nftables"
+}
+
+func (f *ForwardRulesNftablesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render forward rules as an nftables ruleset",
+		Description: "Renders Zeus forward rules as an `nft -f` ruleset for the `ip zeus` table, with a DNAT rule per forward " +
+			"and a matching forward-accept rule. The table is flushed first so the ruleset can be reloaded. " +
+			"Rules are sorted by protocol and inbound port so the output is stable.",
+		Parameters: []function.Parameter{
+			forwardRulesParameter(),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ForwardRulesNftablesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rules []forwardRuleArg
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rules))
+	if resp.Error != nil {
+		return
+	}
+
+	sorted, err := sortForwardRules(rules)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderNftables(sorted)))
+}
+
+var _ function.Function = &ForwardRulesIptablesFunction{}
+
+func NewForwardRulesIptablesFunction() function.Function {
+	return &ForwardRulesIptablesFunction{}
+}
+
+type ForwardRulesIptablesFunction struct{}
+
+func (f *ForwardRulesIptablesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "forward_rules_iptables"
+}
+
+func (f *ForwardRulesIptablesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Render forward rules as an iptables-restore document",
+		Description: "Renders Zeus forward rules as a document for `iptables-restore --noflush`. DNAT rules go to " +
+			"a `ZEUS-PREROUTING` chain in the `nat` table and matching accept rules to a `ZEUS-FORWARD` chain in " +
+			"the `filter` table. Every restore replaces the rules in those chains, and built-in chains, their " +
+			"policies and other rules on the host are left alone, so the document can be restored repeatedly. " +
+			"The jumps to the chains are not part of the document; add them once per host after the first restore " +
+			"with `iptables -t nat -A PREROUTING -j ZEUS-PREROUTING` and `iptables -A FORWARD -j ZEUS-FORWARD`. " +
+			"Rules are sorted by protocol and inbound port so the output is stable.",
+		Parameters: []function.Parameter{
+			forwardRulesParameter(),
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ForwardRulesIptablesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var rules []forwardRuleArg
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &rules))
+	if resp.Error != nil {
+		return
+	}
+
+	sorted, err := sortForwardRules(rules)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(0, err.Error()))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, renderIptables(sorted)))
+}
+
+// sortForwardRules validates the rules and returns a copy ordered by protocol
+// and inbound port. A protocol/port pair may only be forwarded once, so the
+// order does not depend on the order of the input.
+func sortForwardRules(rules []forwardRuleArg) ([]forwardRuleArg, error) {
+	seen := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		if rule.Proto != "tcp" && rule.Proto != "udp" {
+			return nil, fmt.Errorf("rules[%d]: proto must be \"tcp\" or \"udp\", got %q", i, rule.Proto)
+		}
+		if rule.InPort < 1 || rule.InPort > 65535 {
+			return nil, fmt.Errorf("rules[%d]: in_port must be between 1 and 65535", i)
+		}
+		if rule.OutPort < 1 || rule.OutPort > 65535 {
+			return nil, fmt.Errorf("rules[%d]: out_port must be between 1 and 65535", i)
+		}
+		if ip := net.ParseIP(rule.OutHost); ip == nil || ip.To4() == nil {
+			return nil, fmt.Errorf("rules[%d]: out_host must be a valid IPv4 address", i)
+		}
+
+		key := fmt.Sprintf("%s/%d", rule.Proto, rule.InPort)
+		if prev, ok := seen[key]; ok {
+			return nil, fmt.Errorf("rules[%d]: %s port %d is already forwarded by rules[%d]", i, rule.Proto, rule.InPort, prev)
+		}
+		seen[key] = i
+	}
+
+	sorted := append([]forwardRuleArg(nil), rules...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Proto != b.Proto {
+			return a.Proto < b.Proto
+		}
+		return a.InPort < b.InPort
+	})
+	return sorted, nil
+}
+
+func renderNftables(rules []forwardRuleArg) string {
+	var b strings.Builder
+	b.WriteString("table ip zeus\n")
+	b.WriteString("flush table ip zeus\n\n")
+	b.WriteString("table ip zeus {\n")
+	b.WriteString("\tchain prerouting {\n")
+	b.WriteString("\t\ttype nat hook prerouting priority dstnat; policy accept;\n")
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "\t\t%s dport %d dnat to %s:%d\n", rule.Proto, rule.InPort, rule.OutHost, rule.OutPort)
+	}
+	b.WriteString("\t}\n\n")
+	b.WriteString("\tchain forward {\n")
+	b.WriteString("\t\ttype filter hook forward priority filter; policy accept;\n")
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "\t\tip daddr %s %s dport %d ct state new accept\n", rule.OutHost, rule.Proto, rule.OutPort)
+	}
+	b.WriteString("\t}\n")
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// renderIptables keeps the rules in chains of their own so that restoring the
+// document with --noflush replaces them without touching the built-in chains
+// or the rest of the host's ruleset. The jumps from the built-in chains are
+// left to the host setup: --noflush would append another one on every reload.
+func renderIptables(rules []forwardRuleArg) string {
+	var b strings.Builder
+	b.WriteString("*nat\n")
+	b.WriteString(":ZEUS-PREROUTING - [0:0]\n")
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "-A ZEUS-PREROUTING -p %s -m %s --dport %d -j DNAT --to-destination %s:%d\n",
+			rule.Proto, rule.Proto, rule.InPort, rule.OutHost, rule.OutPort)
+	}
+	b.WriteString("COMMIT\n")
+	b.WriteString("*filter\n")
+	b.WriteString(":ZEUS-FORWARD - [0:0]\n")
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "-A ZEUS-FORWARD -d %s/32 -p %s -m %s --dport %d -m conntrack --ctstate NEW -j ACCEPT\n",
+			rule.OutHost, rule.Proto, rule.Proto, rule.OutPort)
+	}
+	b.WriteString("COMMIT\n")
+	return b.String()
+}
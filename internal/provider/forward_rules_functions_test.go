@@ -0,0 +1,214 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func forwardRulesArgument(rules ...forwardRuleArg) attr.Value {
+	elemType := forwardRuleAttrType()
+
+	elems := make([]attr.Value, 0, len(rules))
+	for _, rule := range rules {
+		elems = append(elems, types.ObjectValueMust(elemType.AttrTypes, map[string]attr.Value{
+			"in_port":  types.Int64Value(rule.InPort),
+			"out_port": types.Int64Value(rule.OutPort),
+			"out_host": types.StringValue(rule.OutHost),
+			"proto":    types.StringValue(rule.Proto),
+		}))
+	}
+	return types.ListValueMust(elemType, elems)
+}
+
+var testForwardRules = []forwardRuleArg{
+	{InPort: 32053, OutPort: 53, OutHost: "10.0.0.6", Proto: "udp"},
+	{InPort: 32080, OutPort: 80, OutHost: "10.0.0.5", Proto: "tcp"},
+	{InPort: 32022, OutPort: 22, OutHost: "10.0.0.5", Proto: "tcp"},
+}
+
+func TestForwardRulesNftablesFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"value-valid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(testForwardRules...)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(`table ip zeus
+flush table ip zeus
+
+table ip zeus {
+	chain prerouting {
+		type nat hook prerouting priority dstnat; policy accept;
+		tcp dport 32022 dnat to 10.0.0.5:22
+		tcp dport 32080 dnat to 10.0.0.5:80
+		udp dport 32053 dnat to 10.0.0.6:53
+	}
+
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+		ip daddr 10.0.0.5 tcp dport 22 ct state new accept
+		ip daddr 10.0.0.5 tcp dport 80 ct state new accept
+		ip daddr 10.0.0.6 udp dport 53 ct state new accept
+	}
+}
+`)),
+			},
+		},
+		"value-empty": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument()}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(`table ip zeus
+flush table ip zeus
+
+table ip zeus {
+	chain prerouting {
+		type nat hook prerouting priority dstnat; policy accept;
+	}
+
+	chain forward {
+		type filter hook forward priority filter; policy accept;
+	}
+}
+`)),
+			},
+		},
+		"value-invalid-proto": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(
+					forwardRuleArg{InPort: 32022, OutPort: 22, OutHost: "10.0.0.5", Proto: "icmp"},
+				)}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, `rules[0]: proto must be "tcp" or "udp", got "icmp"`),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&ForwardRulesNftablesFunction{}).Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderIptablesLeavesBuiltinChains(t *testing.T) {
+	t.Parallel()
+
+	out := renderIptables(testForwardRules)
+	for _, chain := range []string{"PREROUTING", "INPUT", "FORWARD", "OUTPUT", "POSTROUTING"} {
+		if strings.Contains(out, "\n:"+chain+" ") {
+			t.Errorf("output sets the policy of built-in chain %s:\n%s", chain, out)
+		}
+		// Restoring with --noflush would append the rule again on every reload.
+		if strings.Contains(out, "\n-A "+chain+" ") {
+			t.Errorf("output appends to built-in chain %s:\n%s", chain, out)
+		}
+	}
+}
+
+func TestForwardRulesIptablesFunctionRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		request  function.RunRequest
+		expected function.RunResponse
+	}{
+		"value-valid": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(testForwardRules...)}),
+			},
+			expected: function.RunResponse{
+				Result: function.NewResultData(types.StringValue(`*nat
+:ZEUS-PREROUTING - [0:0]
+-A ZEUS-PREROUTING -p tcp -m tcp --dport 32022 -j DNAT --to-destination 10.0.0.5:22
+-A ZEUS-PREROUTING -p tcp -m tcp --dport 32080 -j DNAT --to-destination 10.0.0.5:80
+-A ZEUS-PREROUTING -p udp -m udp --dport 32053 -j DNAT --to-destination 10.0.0.6:53
+COMMIT
+*filter
+:ZEUS-FORWARD - [0:0]
+-A ZEUS-FORWARD -d 10.0.0.5/32 -p tcp -m tcp --dport 22 -m conntrack --ctstate NEW -j ACCEPT
+-A ZEUS-FORWARD -d 10.0.0.5/32 -p tcp -m tcp --dport 80 -m conntrack --ctstate NEW -j ACCEPT
+-A ZEUS-FORWARD -d 10.0.0.6/32 -p udp -m udp --dport 53 -m conntrack --ctstate NEW -j ACCEPT
+COMMIT
+`)),
+			},
+		},
+		"value-invalid-port": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(
+					forwardRuleArg{InPort: 32022, OutPort: 70000, OutHost: "10.0.0.5", Proto: "tcp"},
+				)}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "rules[0]: out_port must be between 1 and 65535"),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"value-invalid-host": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(
+					forwardRuleArg{InPort: 32022, OutPort: 22, OutHost: "::1", Proto: "tcp"},
+				)}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "rules[0]: out_host must be a valid IPv4 address"),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+		"value-invalid-duplicate": {
+			request: function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{forwardRulesArgument(
+					forwardRuleArg{InPort: 32022, OutPort: 22, OutHost: "10.0.0.5", Proto: "tcp"},
+					forwardRuleArg{InPort: 32022, OutPort: 22, OutHost: "10.0.0.6", Proto: "tcp"},
+				)}),
+			},
+			expected: function.RunResponse{
+				Error:  function.NewArgumentFuncError(0, "rules[1]: tcp port 32022 is already forwarded by rules[0]"),
+				Result: function.NewResultData(types.StringUnknown()),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			(&ForwardRulesIptablesFunction{}).Run(context.Background(), testCase.request, &got)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const healthCheckOptOut = "Set skip_health_check = true in the provider configuration to skip this check."
+
+// checkZeusConnection calls the health and connection check endpoints so a
+// bad endpoint or token fails the run before any resource is touched.
+// Token problems are reported on tokenAttr, the setting the token comes from.
+func checkZeusConnection(ctx context.Context, client *zeusapi.Client, endpoint, tokenAttr string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if _, err := client.Health(ctx); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) {
+			diags.AddError(
+				"Zeus backend unhealthy",
+				fmt.Sprintf("The health check of %s failed: %s\n\n%s", endpoint, err, healthCheckOptOut),
+			)
+			return diags
+		}
+		diags.AddAttributeError(
+			path.Root("endpoint"),
+			"Zeus endpoint unreachable",
+			fmt.Sprintf("Could not reach Zeus at %s: %s\n\n%s", endpoint, err, healthCheckOptOut),
+		)
+		return diags
+	}
+
+	check, err := client.CheckConnection(ctx)
+	if err != nil {
+		var tokenErr *zeusapi.TokenError
+		if errors.As(err, &tokenErr) {
+			diags.AddAttributeError(
+				path.Root(tokenAttr),
+				"Could not obtain Zeus token",
+				fmt.Sprintf("The token configured with %s could not be obtained: %s\n\n%s", tokenAttr, tokenErr.Err, healthCheckOptOut),
+			)
+			return diags
+		}
+
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.Unauthorized() {
+			diags.AddAttributeError(
+				path.Root(tokenAttr),
+				"Invalid Zeus token",
+				fmt.Sprintf("Zeus at %s rejected the configured token: %s\n\n%s", endpoint, err, healthCheckOptOut),
+			)
+			return diags
+		}
+		diags.AddError(
+			"Zeus connection check failed",
+			fmt.Sprintf("The connection check of %s failed: %s\n\n%s", endpoint, err, healthCheckOptOut),
+		)
+		return diags
+	}
+	if !check.OK {
+		diags.AddError(
+			"Zeus connection check failed",
+			fmt.Sprintf("Zeus at %s did not confirm the connection.\n\n%s", endpoint, healthCheckOptOut),
+		)
+	}
+
+	return diags
+}
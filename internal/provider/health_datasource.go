@@ -0,0 +1,99 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &HealthDataSource{}
+
+func NewHealthDataSource() datasource.DataSource {
+	return &HealthDataSource{}
+}
+
+type HealthDataSource struct {
+	client *zeusapi.Client
+}
+
+type healthDataSourceModel struct {
+	Healthy       types.Bool   `tfsdk:"healthy"`
+	Authenticated types.Bool   `tfsdk:"authenticated"`
+	Error         types.String `tfsdk:"error"`
+}
+
+func (d *HealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *HealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Zeus backend health and token check. Failed checks are reported in the attributes instead of as errors, so they can be asserted in `check` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether `GET /_internal/health` succeeded",
+				Computed:            true,
+			},
+			"authenticated": schema.BoolAttribute{
+				MarkdownDescription: "Whether `GET /connection/check` accepted the configured token",
+				Computed:            true,
+			},
+			"error": schema.StringAttribute{
+				MarkdownDescription: "Reason of the first failed check, null when both checks passed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *HealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *HealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data healthDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Healthy = types.BoolValue(true)
+	data.Authenticated = types.BoolValue(false)
+	data.Error = types.StringNull()
+
+	if _, err := d.client.Health(ctx); err != nil {
+		data.Healthy = types.BoolValue(false)
+		data.Error = types.StringValue("health check: " + err.Error())
+	}
+
+	check, err := d.client.CheckConnection(ctx)
+	switch {
+	case err != nil:
+		if data.Error.IsNull() {
+			data.Error = types.StringValue("connection check: " + err.Error())
+		}
+	case !check.OK:
+		if data.Error.IsNull() {
+			data.Error = types.StringValue("connection check: not confirmed")
+		}
+	default:
+		data.Authenticated = types.BoolValue(true)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
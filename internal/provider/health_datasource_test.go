@@ -0,0 +1,101 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderConfigure_HealthChecks(t *testing.T) {
+	unhealthy := newRawTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "database unavailable"})
+	}))
+
+	healthy := newTestServer(t, http.NotFoundHandler())
+
+	closed := newRawTestServer(t, http.NotFoundHandler())
+	unreachable := closed.URL
+	closed.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccHealthConfig(unreachable, "token", false),
+				ExpectError: regexp.MustCompile(`Zeus endpoint unreachable`),
+			},
+			{
+				Config:      testAccHealthConfig(unhealthy.URL, "token", false),
+				ExpectError: regexp.MustCompile(`Zeus backend unhealthy`),
+			},
+			{
+				Config:      testAccHealthConfig(healthy.URL, "wrong", false),
+				ExpectError: regexp.MustCompile(`Invalid Zeus token`),
+			},
+			{
+				Config: testAccHealthConfig(healthy.URL, "token", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "true"),
+					resource.TestCheckNoResourceAttr("data.zeus_health.this", "error"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccHealthDataSource_SkipHealthCheck(t *testing.T) {
+	healthy := newTestServer(t, http.NotFoundHandler())
+	unhealthy := newRawTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "database unavailable"})
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccHealthConfig(healthy.URL, "wrong", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "false"),
+					resource.TestMatchResourceAttr("data.zeus_health.this", "error", regexp.MustCompile(`status 401`)),
+				),
+			},
+			{
+				Config: testAccHealthConfig(unhealthy.URL, "token", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "false"),
+					resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "false"),
+					resource.TestMatchResourceAttr("data.zeus_health.this", "error", regexp.MustCompile(`database unavailable`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccHealthConfig(endpoint, token string, skip bool) string {
+	skipHealthCheck := "false"
+	if skip {
+		skipHealthCheck = "true"
+	}
+
+	return `
+provider "zeus" {
+  endpoint          = "` + endpoint + `"
+  token             = "` + token + `"
+  skip_health_check = ` + skipHealthCheck + `
+}
+
+data "zeus_health" "this" {}
+`
+}
@@ -0,0 +1,147 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ImageDataSource{}
+
+func NewImageDataSource() datasource.DataSource {
+	return &ImageDataSource{}
+}
+
+type ImageDataSource struct {
+	client *zeusapi.Client
+}
+
+type imageDataSourceModel struct {
+	Flavor    types.String  `tfsdk:"flavor"`
+	Version   types.String  `tfsdk:"version"`
+	ID        types.String  `tfsdk:"id"`
+	CreatedAt types.String  `tfsdk:"created_at"`
+	ImageURL  types.String  `tfsdk:"image_url"`
+	Extra     types.Dynamic `tfsdk:"extra"`
+}
+
+// imageKnownFields are the SystemImage fields mapped to typed attributes; any
+// other upstream field ends up in extra.
+var imageKnownFields = map[string]struct{}{
+	"id":        {},
+	"createdAt": {},
+	"flavor":    {},
+	"version":   {},
+	"imageUrl":  {},
+}
+
+func (d *ImageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_image"
+}
+
+func (d *ImageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a system image from the upstream catalog proxied by Zeus",
+		Attributes: map[string]schema.Attribute{
+			"flavor": schema.StringAttribute{
+				MarkdownDescription: "Image flavor",
+				Required:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Image version. When omitted the latest image of the flavor is returned.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"image_url": schema.StringAttribute{
+				Computed: true,
+			},
+			"extra": schema.DynamicAttribute{
+				MarkdownDescription: "Any other fields returned by the upstream catalog, keyed as returned",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ImageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *ImageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data imageDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var image zeusapi.SystemImage
+	var err error
+	if data.Version.IsNull() {
+		image, err = d.client.GetLatestImage(ctx, data.Flavor.ValueString())
+	} else {
+		image, err = d.client.GetImage(ctx, data.Flavor.ValueString(), data.Version.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Read image failed", err.Error())
+		return
+	}
+
+	data.ID = imageStringField(image, "id")
+	data.CreatedAt = imageStringField(image, "createdAt")
+	data.ImageURL = imageStringField(image, "imageUrl")
+	if data.Version.IsNull() {
+		data.Version = imageStringField(image, "version")
+	}
+
+	extra := make(map[string]any)
+	for key, value := range image {
+		if _, ok := imageKnownFields[key]; ok {
+			continue
+		}
+		extra[key] = value
+	}
+	dyn, err := dynamicFromInterface(extra)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid image extra fields", err.Error())
+		return
+	}
+	data.Extra = dyn
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func imageStringField(image zeusapi.SystemImage, key string) types.String {
+	switch v := image[key].(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	case json.Number:
+		return types.StringValue(v.String())
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
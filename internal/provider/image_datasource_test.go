@@ -0,0 +1,86 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImageDataSource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/images/latest" && r.URL.Query().Get("flavor") == "debian":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":        "img-13",
+				"createdAt": "2024-06-01T00:00:00Z",
+				"flavor":    "debian",
+				"version":   "13",
+				"imageUrl":  "https://images.example.com/debian-13.qcow2",
+				"sha256":    "abc123",
+				"sizeBytes": 1024,
+				"tags":      []any{"stable", 13},
+				"build":     json.Number("9007199254740995"),
+				"mirror":    nil,
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/image/debian/12":
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"id":        json.Number("9007199254740993"),
+				"createdAt": "2023-06-10T00:00:00Z",
+				"flavor":    "debian",
+				"version":   12,
+				"imageUrl":  "https://images.example.com/debian-12.qcow2",
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccImageConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "id", "img-13"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "version", "13"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "image_url", "https://images.example.com/debian-13.qcow2"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "extra.sha256", "abc123"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "extra.sizeBytes", "1024"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "extra.tags.1", "13"),
+				resource.TestCheckResourceAttr("data.zeus_image.latest", "extra.build", "9007199254740995"),
+				resource.TestCheckResourceAttr("data.zeus_image.pinned", "id", "9007199254740993"),
+				resource.TestCheckResourceAttr("data.zeus_image.pinned", "version", "12"),
+				resource.TestCheckResourceAttr("data.zeus_image.pinned", "created_at", "2023-06-10T00:00:00Z"),
+				resource.TestCheckResourceAttr("data.zeus_image.pinned", "extra.%", "0"),
+			),
+		}},
+	})
+}
+
+func testAccImageConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_image" "latest" {
+  flavor = "debian"
+}
+
+data "zeus_image" "pinned" {
+  flavor  = "debian"
+  version = "12"
+}
+`
+}
@@ -0,0 +1,80 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// importedPrivateKey marks a resource adopted with terraform import whose
+// create-only settings Zeus cannot report back. Until the next apply, plans
+// take those settings from the configuration instead of replacing the
+// resource.
+const importedPrivateKey = "imported"
+
+var importedPrivateValue = []byte("true")
+
+type privateStateGetter interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+func wasImported(ctx context.Context, private privateStateGetter) (bool, diag.Diagnostics) {
+	value, diags := private.GetKey(ctx, importedPrivateKey)
+	return len(value) > 0, diags
+}
+
+const adoptAfterImportDescription = "requires resource replacement if the value changes, unless the resource was just imported and the value could not be read back"
+
+// requiresReplaceUnlessImportedString is RequiresReplace, except that a null
+// value left by import is filled in from the configuration.
+func requiresReplaceUnlessImportedString() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			imported, diags := wasImported(ctx, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !imported || !req.StateValue.IsNull()
+		},
+		adoptAfterImportDescription,
+		adoptAfterImportDescription,
+	)
+}
+
+// requiresReplaceUnlessImportedList is RequiresReplace, except that after
+// import a list rebuilt in a different order than configured is reordered in
+// place.
+func requiresReplaceUnlessImportedList() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			imported, diags := wasImported(ctx, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = !imported || !sameElements(req.StateValue.Elements(), req.PlanValue.Elements())
+		},
+		adoptAfterImportDescription,
+		adoptAfterImportDescription,
+	)
+}
+
+func sameElements(a, b []attr.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[string]int, len(a))
+	for _, v := range a {
+		counts[v.String()]++
+	}
+	for _, v := range b {
+		if counts[v.String()] == 0 {
+			return false
+		}
+		counts[v.String()]--
+	}
+	return true
+}
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 
@@ -26,6 +27,14 @@ func dynamicToJSONCompatible(v types.Dynamic) (any, error) {
 
 func attrValueToJSONCompatible(v attr.Value) (any, error) {
 	switch tv := v.(type) {
+	case types.Dynamic:
+		if tv.IsUnknown() {
+			return nil, fmt.Errorf("dynamic value must be known")
+		}
+		if tv.IsNull() || tv.IsUnderlyingValueNull() {
+			return nil, nil
+		}
+		return attrValueToJSONCompatible(tv.UnderlyingValue())
 	case types.String:
 		if tv.IsUnknown() {
 			return nil, fmt.Errorf("string value must be known")
@@ -169,8 +178,12 @@ func dynamicFromInterface(v any) (types.Dynamic, error) {
 	return types.DynamicValue(av), nil
 }
 
+// interfaceToAttrValue converts decoded JSON into framework values. JSON
+// objects and arrays become objects and tuples so mixed element types survive.
 func interfaceToAttrValue(v any) (attr.Value, error) {
 	switch tv := v.(type) {
+	case nil:
+		return types.DynamicNull(), nil
 	case string:
 		return types.StringValue(tv), nil
 	case bool:
@@ -186,27 +199,45 @@ func interfaceToAttrValue(v any) (attr.Value, error) {
 			return types.NumberNull(), nil
 		}
 		return types.NumberValue(tv), nil
+	case json.Number:
+		// Parsed at Terraform's own number precision so no digit is lost.
+		f, _, err := big.ParseFloat(tv.String(), 10, 512, big.ToNearestEven)
+		if err != nil {
+			return types.DynamicNull(), fmt.Errorf("invalid number %q: %w", tv, err)
+		}
+		return types.NumberValue(f), nil
 	case []any:
+		elemTypes := make([]attr.Type, 0, len(tv))
 		elems := make([]attr.Value, 0, len(tv))
 		for i, ev := range tv {
 			inner, err := interfaceToAttrValue(ev)
 			if err != nil {
 				return types.DynamicNull(), fmt.Errorf("list[%d]: %w", i, err)
 			}
-			elems = append(elems, types.DynamicValue(inner))
+			elemTypes = append(elemTypes, types.DynamicType)
+			elems = append(elems, asDynamic(inner))
 		}
-		return types.ListValueMust(types.DynamicType, elems), nil
+		return types.TupleValueMust(elemTypes, elems), nil
 	case map[string]any:
+		attrTypes := make(map[string]attr.Type, len(tv))
 		elems := make(map[string]attr.Value, len(tv))
 		for k, ev := range tv {
 			inner, err := interfaceToAttrValue(ev)
 			if err != nil {
 				return types.DynamicNull(), fmt.Errorf("map[%q]: %w", k, err)
 			}
-			elems[k] = types.DynamicValue(inner)
+			attrTypes[k] = types.DynamicType
+			elems[k] = asDynamic(inner)
 		}
-		return types.MapValueMust(types.DynamicType, elems), nil
+		return types.ObjectValueMust(attrTypes, elems), nil
 	default:
 		return types.DynamicNull(), fmt.Errorf("unsupported json type %T", v)
 	}
 }
+
+func asDynamic(v attr.Value) types.Dynamic {
+	if dv, ok := v.(types.Dynamic); ok {
+		return dv
+	}
+	return types.DynamicValue(v)
+}
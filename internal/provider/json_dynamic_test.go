@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -71,3 +72,61 @@ func TestDynamicToJSONCompatible(t *testing.T) {
 		t.Fatalf("unexpected diff: %s", diff)
 	}
 }
+
+func TestDynamicFromInterfaceJSONNumber(t *testing.T) {
+	t.Parallel()
+
+	got, err := dynamicFromInterface(map[string]any{"id": json.Number("9007199254740993")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	obj, ok := got.UnderlyingValue().(types.Object)
+	if !ok {
+		t.Fatalf("expected an object, got %T", got.UnderlyingValue())
+	}
+	id, ok := obj.Attributes()["id"].(types.Dynamic)
+	if !ok {
+		t.Fatalf("expected a dynamic id, got %T", obj.Attributes()["id"])
+	}
+	num, ok := id.UnderlyingValue().(types.Number)
+	if !ok {
+		t.Fatalf("expected a number, got %T", id.UnderlyingValue())
+	}
+	if s := num.ValueBigFloat().Text('f', -1); s != "9007199254740993" {
+		t.Fatalf("number was rounded to %s", s)
+	}
+
+	if _, err := dynamicFromInterface(json.Number("not-a-number")); err == nil {
+		t.Fatal("expected an error for an invalid number")
+	}
+}
+
+func TestDynamicFromInterfaceMixedTypes(t *testing.T) {
+	t.Parallel()
+
+	in := map[string]any{
+		"name":   "debian",
+		"size":   float64(1024),
+		"tags":   []any{"stable", float64(13), true},
+		"parent": nil,
+		"meta": map[string]any{
+			"arch": "amd64",
+			"bits": float64(64),
+		},
+	}
+
+	got, err := dynamicFromInterface(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	roundTrip, err := dynamicToJSONCompatible(got)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if diff := cmp.Diff(in, roundTrip); diff != "" {
+		t.Fatalf("unexpected diff: %s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PoolInfoDataSource{}
+
+func NewPoolInfoDataSource() datasource.DataSource {
+	return &PoolInfoDataSource{}
+}
+
+type PoolInfoDataSource struct {
+	client *zeusapi.Client
+}
+
+type poolInfoDataSourceModel struct {
+	Regions map[string]poolUsageModel `tfsdk:"regions"`
+}
+
+type poolUsageModel struct {
+	FriendlyName types.String  `tfsdk:"friendly_name"`
+	Size         types.Int64   `tfsdk:"size"`
+	Used         types.Int64   `tfsdk:"used"`
+	Free         types.Int64   `tfsdk:"free"`
+	Utilization  types.Float64 `tfsdk:"utilization"`
+}
+
+func (d *PoolInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_info"
+}
+
+func (d *PoolInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Address capacity and usage per region",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.MapNestedAttribute{
+				MarkdownDescription: "Pool usage keyed by region",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"friendly_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Total addresses across the region's pools",
+							Computed:            true,
+						},
+						"used": schema.Int64Attribute{
+							MarkdownDescription: "Allocated addresses",
+							Computed:            true,
+						},
+						"free": schema.Int64Attribute{
+							MarkdownDescription: "Addresses still available (`size - used`)",
+							Computed:            true,
+						},
+						"utilization": schema.Float64Attribute{
+							MarkdownDescription: "Percentage of addresses in use, from 0 to 100",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoolInfoDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data poolInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	info, err := d.client.GetPoolInfo(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read pool info failed", err.Error())
+		return
+	}
+
+	data.Regions = make(map[string]poolUsageModel, len(info))
+	for region, usage := range info {
+		var utilization float64
+		if usage.Size > 0 {
+			utilization = float64(usage.Used) / float64(usage.Size) * 100
+		}
+		data.Regions[region] = poolUsageModel{
+			FriendlyName: types.StringValue(usage.FriendlyName),
+			Size:         types.Int64Value(usage.Size),
+			Used:         types.Int64Value(usage.Used),
+			Free:         types.Int64Value(max(usage.Size-usage.Used, 0)),
+			Utilization:  types.Float64Value(utilization),
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
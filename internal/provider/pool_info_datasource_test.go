@@ -0,0 +1,58 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoolInfoDataSource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/info":
+			_ = json.NewEncoder(w).Encode(map[string]zeusapi.PoolUsage{
+				"us-east-1": {Size: 200, Used: 150, FriendlyName: "US East"},
+				"eu-west-1": {Size: 0, Used: 0, FriendlyName: "EU West"},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccPoolInfoConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_pool_info.all", "regions.%", "2"),
+				resource.TestCheckResourceAttr("data.zeus_pool_info.all", "regions.us-east-1.friendly_name", "US East"),
+				resource.TestCheckResourceAttr("data.zeus_pool_info.all", "regions.us-east-1.free", "50"),
+				resource.TestCheckResourceAttr("data.zeus_pool_info.all", "regions.us-east-1.utilization", "75"),
+				resource.TestCheckResourceAttr("data.zeus_pool_info.all", "regions.eu-west-1.utilization", "0"),
+			),
+		}},
+	})
+}
+
+func testAccPoolInfoConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_pool_info" "all" {}
+`
+}
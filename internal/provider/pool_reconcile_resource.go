@@ -0,0 +1,194 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &PoolReconcileResource{}
+
+func NewPoolReconcileResource() resource.Resource {
+	return &PoolReconcileResource{}
+}
+
+type PoolReconcileResource struct {
+	client *zeusapi.Client
+}
+
+type poolReconcileModel struct {
+	ID           types.String `tfsdk:"id"`
+	PoolID       types.String `tfsdk:"pool_id"`
+	Triggers     types.Map    `tfsdk:"triggers"`
+	Region       types.String `tfsdk:"region"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Begin        types.String `tfsdk:"begin"`
+	End          types.String `tfsdk:"end"`
+	GatewayIP    types.String `tfsdk:"gateway_ip"`
+	State        types.List   `tfsdk:"state"`
+	ChangedSlots types.Int64  `tfsdk:"changed_slots"`
+}
+
+func (r *PoolReconcileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pool_reconcile"
+}
+
+func (r *PoolReconcileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rebuilds a pool's allocation state from its actual leases. " +
+			"The reconcile runs on create; change `triggers` to run it again. " +
+			"Destroying this resource does not touch the pool.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pool_id": schema.StringAttribute{
+				MarkdownDescription: "Pool ID to reconcile",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that cause the reconcile to run again when changed",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Computed: true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"begin": schema.StringAttribute{
+				Computed: true,
+			},
+			"end": schema.StringAttribute{
+				Computed: true,
+			},
+			"gateway_ip": schema.StringAttribute{
+				Computed: true,
+			},
+			"state": schema.ListAttribute{
+				MarkdownDescription: "Pool allocation state after the reconcile",
+				Computed:            true,
+				ElementType:         types.Int64Type,
+			},
+			"changed_slots": schema.Int64Attribute{
+				MarkdownDescription: "Number of slots whose state was changed by the reconcile",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *PoolReconcileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *PoolReconcileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan poolReconcileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	poolID := plan.PoolID.ValueString()
+
+	before, err := r.client.GetPoolByID(ctx, poolID)
+	if err != nil {
+		resp.Diagnostics.AddError("Read pool before reconcile failed", err.Error())
+		return
+	}
+
+	after, err := r.client.ReconcilePool(ctx, poolID)
+	if err != nil {
+		resp.Diagnostics.AddError("Reconcile pool failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(poolID)
+	plan.Region = types.StringValue(after.Region)
+	plan.FriendlyName = types.StringValue(after.FriendlyName)
+	plan.Begin = types.StringValue(after.Begin)
+	plan.End = types.StringValue(after.End)
+	plan.GatewayIP = types.StringValue(after.Gateway)
+	plan.ChangedSlots = types.Int64Value(changedPoolSlots(before.State, after.State))
+
+	state, diags := types.ListValueFrom(ctx, types.Int64Type, after.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.State = state
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read keeps the recorded result: the reconcile is a one-shot operation and
+// the pool itself is tracked by zeus_pool.
+func (r *PoolReconcileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state poolReconcileModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *PoolReconcileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan poolReconcileModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *PoolReconcileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// changedPoolSlots counts slots that differ between two pool states. Zeus may
+// return a state shorter than the pool size; missing slots are unallocated.
+func changedPoolSlots(before, after []int64) int64 {
+	var changed int64
+	for i := range max(len(before), len(after)) {
+		var b, a int64
+		if i < len(before) {
+			b = before[i]
+		}
+		if i < len(after) {
+			a = after[i]
+		}
+		if a != b {
+			changed++
+		}
+	}
+	return changed
+}
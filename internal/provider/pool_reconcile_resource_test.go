@@ -0,0 +1,131 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPoolReconcileResource(t *testing.T) {
+	var mu sync.Mutex
+	state := []int64{1, 1, 2, 1}
+	reconciles := 0
+
+	detail := func() zeusapi.PoolDetail {
+		return zeusapi.PoolDetail{
+			ID:           "pool-1",
+			Region:       "us-east-1",
+			FriendlyName: "primary",
+			Begin:        "10.0.0.0",
+			End:          "10.0.0.9",
+			Gateway:      "10.0.0.1",
+			State:        append([]int64(nil), state...),
+		}
+	}
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/id/pool-1":
+			_ = json.NewEncoder(w).Encode(detail())
+		case r.Method == http.MethodPost && r.URL.Path == "/pool/pool-1/reconcile":
+			reconciles++
+			// Only the first and the disabled slot still have leases.
+			state = []int64{1, 0, 2}
+			_ = json.NewEncoder(w).Encode(detail())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	checkReconciles := func(want int) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			mu.Lock()
+			defer mu.Unlock()
+			if reconciles != want {
+				return fmt.Errorf("expected %d reconcile calls, got %d", want, reconciles)
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoolReconcileConfig(server.URL, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_pool_reconcile.test", "id", "pool-1"),
+					resource.TestCheckResourceAttr("zeus_pool_reconcile.test", "changed_slots", "2"),
+					resource.TestCheckResourceAttr("zeus_pool_reconcile.test", "state.#", "3"),
+					resource.TestCheckResourceAttr("zeus_pool_reconcile.test", "region", "us-east-1"),
+					checkReconciles(1),
+				),
+			},
+			{
+				Config: testAccPoolReconcileConfig(server.URL, "1"),
+				Check:  checkReconciles(1),
+			},
+			{
+				Config: testAccPoolReconcileConfig(server.URL, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_pool_reconcile.test", "changed_slots", "0"),
+					checkReconciles(2),
+				),
+			},
+		},
+	})
+}
+
+func TestChangedPoolSlots(t *testing.T) {
+	cases := []struct {
+		before, after []int64
+		want          int64
+	}{
+		{nil, nil, 0},
+		{[]int64{1, 1, 0}, []int64{1, 1, 0}, 0},
+		{[]int64{1, 1, 1}, []int64{1, 0, 1}, 1},
+		{[]int64{1, 1, 2, 1}, []int64{1, 0, 2}, 2},
+		{[]int64{1, 0, 0}, []int64{1}, 0},
+		{[]int64{}, []int64{0, 1}, 1},
+	}
+
+	for _, tc := range cases {
+		if got := changedPoolSlots(tc.before, tc.after); got != tc.want {
+			t.Errorf("changedPoolSlots(%v, %v) = %d, want %d", tc.before, tc.after, got, tc.want)
+		}
+	}
+}
+
+func testAccPoolReconcileConfig(endpoint, run string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_pool_reconcile" "test" {
+  pool_id = "pool-1"
+  triggers = {
+    run = "` + run + `"
+  }
+}
+`
+}
@@ -0,0 +1,198 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PoolsDataSource{}
+
+func NewPoolsDataSource() datasource.DataSource {
+	return &PoolsDataSource{}
+}
+
+type PoolsDataSource struct {
+	client *zeusapi.Client
+}
+
+type poolsDataSourceModel struct {
+	Region       types.String          `tfsdk:"region"`
+	ContainingIP types.String          `tfsdk:"containing_ip"`
+	FriendlyName types.String          `tfsdk:"friendly_name"`
+	Pools        []poolsDataSourceItem `tfsdk:"pools"`
+}
+
+type poolsDataSourceItem struct {
+	ID           types.String `tfsdk:"id"`
+	Region       types.String `tfsdk:"region"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	Begin        types.String `tfsdk:"begin"`
+	End          types.String `tfsdk:"end"`
+	GatewayIP    types.String `tfsdk:"gateway_ip"`
+	Size         types.Int64  `tfsdk:"size"`
+	Allocated    types.Int64  `tfsdk:"allocated"`
+	Disabled     types.Int64  `tfsdk:"disabled"`
+	Free         types.Int64  `tfsdk:"free"`
+}
+
+// Pool slot states as reported in PoolDetail.state.
+const (
+	poolSlotAllocated = 1
+	poolSlotDisabled  = 2
+)
+
+func (d *PoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pools"
+}
+
+func (d *PoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List Zeus pools in a region",
+		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region identifier",
+				Required:            true,
+			},
+			"containing_ip": schema.StringAttribute{
+				MarkdownDescription: "Only return pools whose range contains this IPv4 address",
+				Optional:            true,
+			},
+			"friendly_name": schema.StringAttribute{
+				MarkdownDescription: "Only return pools with this friendly name",
+				Optional:            true,
+			},
+			"pools": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"region": schema.StringAttribute{
+							Computed: true,
+						},
+						"friendly_name": schema.StringAttribute{
+							Computed: true,
+						},
+						"begin": schema.StringAttribute{
+							Computed: true,
+						},
+						"end": schema.StringAttribute{
+							Computed: true,
+						},
+						"gateway_ip": schema.StringAttribute{
+							Computed: true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Number of addresses between `begin` and `end`",
+							Computed:            true,
+						},
+						"allocated": schema.Int64Attribute{
+							Computed: true,
+						},
+						"disabled": schema.Int64Attribute{
+							Computed: true,
+						},
+						"free": schema.Int64Attribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *PoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data poolsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var containing int64
+	filterIP := !data.ContainingIP.IsNull()
+	if filterIP {
+		addr, err := ipv4IPToLong(data.ContainingIP.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("containing_ip"), "Invalid containing_ip", err.Error())
+			return
+		}
+		containing = addr
+	}
+
+	pools, err := d.client.ListPoolsByRegion(ctx, data.Region.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("List pools failed", err.Error())
+		return
+	}
+
+	data.Pools = make([]poolsDataSourceItem, 0, len(pools))
+	for _, pool := range pools {
+		if !data.FriendlyName.IsNull() && pool.FriendlyName != data.FriendlyName.ValueString() {
+			continue
+		}
+
+		begin, err := ipv4IPToLong(pool.Begin)
+		if err != nil {
+			resp.Diagnostics.AddError("List pools failed", fmt.Sprintf("pool %s has an invalid begin address: %s", pool.ID, err))
+			return
+		}
+		end, err := ipv4IPToLong(pool.End)
+		if err != nil {
+			resp.Diagnostics.AddError("List pools failed", fmt.Sprintf("pool %s has an invalid end address: %s", pool.ID, err))
+			return
+		}
+		if filterIP && (containing < begin || containing > end) {
+			continue
+		}
+
+		var allocated, disabled int64
+		for _, slot := range pool.State {
+			switch slot {
+			case poolSlotAllocated:
+				allocated++
+			case poolSlotDisabled:
+				disabled++
+			}
+		}
+		size := end - begin + 1
+
+		data.Pools = append(data.Pools, poolsDataSourceItem{
+			ID:           types.StringValue(pool.ID),
+			Region:       types.StringValue(pool.Region),
+			FriendlyName: types.StringValue(pool.FriendlyName),
+			Begin:        types.StringValue(pool.Begin),
+			End:          types.StringValue(pool.End),
+			GatewayIP:    types.StringValue(pool.Gateway),
+			Size:         types.Int64Value(size),
+			Allocated:    types.Int64Value(allocated),
+			Disabled:     types.Int64Value(disabled),
+			Free:         types.Int64Value(max(size-allocated-disabled, 0)),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,91 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPoolsDataSource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/region/us-east-1":
+			_ = json.NewEncoder(w).Encode([]zeusapi.PoolDetail{
+				{
+					ID:           "pool-1",
+					Region:       "us-east-1",
+					FriendlyName: "public",
+					Begin:        "10.0.0.0",
+					End:          "10.0.0.9",
+					Gateway:      "10.0.0.1",
+					State:        []int64{1, 1, 2, 0, 1},
+				},
+				{
+					ID:           "pool-2",
+					Region:       "us-east-1",
+					FriendlyName: "private",
+					Begin:        "192.168.0.0",
+					End:          "192.168.0.255",
+					Gateway:      "192.168.0.1",
+					State:        []int64{},
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccPoolsConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.#", "2"),
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.0.size", "10"),
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.0.allocated", "3"),
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.0.disabled", "1"),
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.0.free", "6"),
+				resource.TestCheckResourceAttr("data.zeus_pools.all", "pools.1.size", "256"),
+				resource.TestCheckResourceAttr("data.zeus_pools.by_ip", "pools.#", "1"),
+				resource.TestCheckResourceAttr("data.zeus_pools.by_ip", "pools.0.id", "pool-2"),
+				resource.TestCheckResourceAttr("data.zeus_pools.by_name", "pools.#", "1"),
+				resource.TestCheckResourceAttr("data.zeus_pools.by_name", "pools.0.gateway_ip", "10.0.0.1"),
+			),
+		}},
+	})
+}
+
+func testAccPoolsConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_pools" "all" {
+  region = "us-east-1"
+}
+
+data "zeus_pools" "by_ip" {
+  region        = "us-east-1"
+  containing_ip = "192.168.0.42"
+}
+
+data "zeus_pools" "by_name" {
+  region        = "us-east-1"
+  friendly_name = "public"
+}
+`
+}
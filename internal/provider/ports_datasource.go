@@ -0,0 +1,115 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &PortsDataSource{}
+
+func NewPortsDataSource() datasource.DataSource {
+	return &PortsDataSource{}
+}
+
+type PortsDataSource struct {
+	client *zeusapi.Client
+}
+
+type portsDataSourceModel struct {
+	ScopeHost types.String          `tfsdk:"scope_host"`
+	AssignID  types.String          `tfsdk:"assign_id"`
+	Ports     []portsDataSourceItem `tfsdk:"ports"`
+}
+
+type portsDataSourceItem struct {
+	ID         types.String `tfsdk:"id"`
+	Port       types.Int64  `tfsdk:"port"`
+	TargetPort types.Int64  `tfsdk:"target_port"`
+	Service    types.String `tfsdk:"service"`
+}
+
+func (d *PortsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ports"
+}
+
+func (d *PortsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the ports allocated to an assign",
+		Attributes: map[string]schema.Attribute{
+			"scope_host": schema.StringAttribute{
+				MarkdownDescription: "Port scope host",
+				Required:            true,
+			},
+			"assign_id": schema.StringAttribute{
+				MarkdownDescription: "Assign ID the ports were allocated for",
+				Required:            true,
+			},
+			"ports": schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"port": schema.Int64Attribute{
+							MarkdownDescription: "Public port on the scope host",
+							Computed:            true,
+						},
+						"target_port": schema.Int64Attribute{
+							Computed: true,
+						},
+						"service": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PortsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *PortsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data portsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ports, err := d.client.ListAssignPorts(ctx, data.ScopeHost.ValueString(), data.AssignID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("List ports failed", err.Error())
+		return
+	}
+
+	data.Ports = make([]portsDataSourceItem, 0, len(ports))
+	for _, port := range ports {
+		data.Ports = append(data.Ports, portsDataSourceItem{
+			ID:         types.StringValue(port.ID),
+			Port:       types.Int64Value(port.Port),
+			TargetPort: types.Int64Value(port.TargetPort),
+			Service:    types.StringValue(port.Service),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
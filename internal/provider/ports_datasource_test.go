@@ -0,0 +1,68 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccPortsDataSource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ports/node-1/assign-1":
+			_ = json.NewEncoder(w).Encode([]map[string]any{
+				{"id": "port-1", "port": 32022, "targetPort": 22, "service": "ssh"},
+				{"id": "port-2", "port": 32080, "targetPort": 80, "service": "http"},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/ports/node-1/assign-2":
+			_ = json.NewEncoder(w).Encode([]map[string]any{})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccPortsConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_ports.vm", "ports.#", "2"),
+				resource.TestCheckResourceAttr("data.zeus_ports.vm", "ports.0.id", "port-1"),
+				resource.TestCheckResourceAttr("data.zeus_ports.vm", "ports.0.port", "32022"),
+				resource.TestCheckResourceAttr("data.zeus_ports.vm", "ports.0.target_port", "22"),
+				resource.TestCheckResourceAttr("data.zeus_ports.vm", "ports.1.service", "http"),
+				resource.TestCheckResourceAttr("data.zeus_ports.empty", "ports.#", "0"),
+			),
+		}},
+	})
+}
+
+func testAccPortsConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_ports" "vm" {
+  scope_host = "node-1"
+  assign_id  = "assign-1"
+}
+
+data "zeus_ports" "empty" {
+  scope_host = "node-1"
+  assign_id  = "assign-2"
+}
+`
+}
@@ -5,14 +5,20 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 // Ensure ZeusProvider satisfies various provider interfaces.
@@ -29,8 +35,22 @@ type ZeusProvider struct {
 
 // ZeusProviderModel describes the provider data model.
 type ZeusProviderModel struct {
-	Endpoint types.String `tfsdk:"endpoint"`
-	Token    types.String `tfsdk:"token"`
+	Endpoint              types.String  `tfsdk:"endpoint"`
+	Token                 types.String  `tfsdk:"token"`
+	TokenFile             types.String  `tfsdk:"token_file"`
+	TokenCommand          types.List    `tfsdk:"token_command"`
+	Profile               types.String  `tfsdk:"profile"`
+	SkipHealthCheck       types.Bool    `tfsdk:"skip_health_check"`
+	MaxRetries            types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait          types.String  `tfsdk:"retry_max_wait"`
+	RequestsPerSecond     types.Float64 `tfsdk:"requests_per_second"`
+	MaxConcurrentRequests types.Int64   `tfsdk:"max_concurrent_requests"`
+	CACertPEM             types.String  `tfsdk:"ca_cert_pem"`
+	CACertFile            types.String  `tfsdk:"ca_cert_file"`
+	ClientCert            types.String  `tfsdk:"client_cert"`
+	ClientKey             types.String  `tfsdk:"client_key"`
+	TLSServerName         types.String  `tfsdk:"tls_server_name"`
+	InsecureSkipVerify    types.Bool    `tfsdk:"insecure_skip_verify"`
 }
 
 func (p *ZeusProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -42,14 +62,72 @@ func (p *ZeusProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"endpoint": schema.StringAttribute{
-				MarkdownDescription: "Zeus API endpoint, e.g. http://host:port",
-				Required:            true,
+				MarkdownDescription: "Zeus API endpoint, e.g. http://host:port. Falls back to `ZEUS_ENDPOINT`, then to `endpoint` in the selected profile of the Zeus config file.",
+				Optional:            true,
 			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "Bearer token for Zeus API",
-				Required:            true,
+				MarkdownDescription: "Bearer token for Zeus API. Falls back to `ZEUS_TOKEN`, then to `token` in the selected profile of the Zeus config file.",
+				Optional:            true,
 				Sensitive:           true,
 			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file holding the bearer token, e.g. one kept up to date by a vault agent. The file is read on first use and again whenever Zeus rejects the token. Conflicts with `token` and `token_command`.",
+				Optional:            true,
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: "Command and arguments that print the bearer token on standard output, like a kubectl exec credential plugin. The command runs on first use and again whenever Zeus rejects the token. Conflicts with `token` and `token_file`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"profile": schema.StringAttribute{
+				MarkdownDescription: "Profile of the Zeus config file to read `endpoint` and `token` from. Falls back to `ZEUS_PROFILE`, then to `default`. The config file is `~/.config/zeus/config` unless `ZEUS_CONFIG_FILE` is set.",
+				Optional:            true,
+			},
+			"skip_health_check": schema.BoolAttribute{
+				MarkdownDescription: "Skip the health and token checks made when the provider is configured. Defaults to `false`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "How many times a failed request is retried. Idempotent requests are retried on connection errors and on status 429, 502, 503 and 504; other requests only when the connection could not be established. Defaults to `3`, `0` disables retries.",
+				Optional:            true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: "Maximum delay between two attempts as a Go duration, e.g. `10s`. Also caps delays requested by `Retry-After`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of requests sent to Zeus, shared by all resources and data sources of this provider. Defaults to `0` (unlimited).",
+				Optional:            true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests in flight at once, shared by all resources and data sources of this provider. Defaults to `0` (unlimited).",
+				Optional:            true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates trusted in addition to the system trust store. Conflicts with `ca_cert_file`.",
+				Optional:            true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA bundle trusted in addition to the system trust store. Conflicts with `ca_cert_pem`.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS. Requires `client_key`.",
+				Optional:            true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"tls_server_name": schema.StringAttribute{
+				MarkdownDescription: "Server name used for SNI and certificate verification instead of the endpoint host.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Disable verification of the server certificate. Only meant for testing.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -63,21 +141,129 @@ func (p *ZeusProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
-	client, err := zeusapi.NewClient(data.Endpoint.ValueString(), data.Token.ValueString(), nil)
+	// Settings such as endpoint may come from a resource that has not been
+	// created yet. A client built from the unknown values would fail with
+	// confusing errors, so wait until Terraform configures the provider again
+	// with the final values.
+	if !req.Config.Raw.IsFullyKnown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			resp.Deferred = &provider.Deferred{Reason: provider.DeferredReasonProviderConfigUnknown}
+			return
+		}
+
+		tflog.Info(ctx, "Zeus provider configuration is not known yet, requests will fail until apply")
+		client := zeusapi.NewUnconfiguredClient(errProviderConfigUnknown)
+		resp.DataSourceData = client
+		resp.ResourceData = client
+		return
+	}
+
+	opts, diags := clientOptions(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	httpClient, diags := buildHTTPClient(data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenSource, diags := configuredTokenSource(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if tokenSource != nil {
+		opts = append(opts, zeusapi.WithTokenSource(tokenSource))
+	}
+
+	endpoint, token, diags := resolveConnectionSettings(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	client, err := zeusapi.NewClient(endpoint.Value, token.Value, httpClient, opts...)
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid client configuration", err.Error())
 		return
 	}
 
+	if !data.SkipHealthCheck.ValueBool() {
+		resp.Diagnostics.Append(checkZeusConnection(ctx, client, endpoint.Value, tokenAttribute(data))...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// errProviderConfigUnknown is returned by every request made while the
+// provider configuration still holds unknown values.
+var errProviderConfigUnknown = errors.New("the Zeus provider configuration depends on values that are not known until apply, " +
+	"for example an endpoint taken from a resource that has not been created yet; " +
+	"create those resources first with -target or use a Terraform version that supports deferred actions")
+
+func clientOptions(data ZeusProviderModel) ([]zeusapi.Option, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var opts []zeusapi.Option
+
+	if !data.MaxRetries.IsNull() {
+		maxRetries := data.MaxRetries.ValueInt64()
+		if maxRetries < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid max_retries", "max_retries must not be negative.")
+		} else {
+			opts = append(opts, zeusapi.WithMaxRetries(int(maxRetries)))
+		}
+	}
+
+	if !data.RetryMaxWait.IsNull() {
+		wait, err := time.ParseDuration(data.RetryMaxWait.ValueString())
+		switch {
+		case err != nil:
+			diags.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait", fmt.Sprintf("retry_max_wait must be a duration such as \"30s\": %s", err))
+		case wait < 0:
+			diags.AddAttributeError(path.Root("retry_max_wait"), "Invalid retry_max_wait", "retry_max_wait must not be negative.")
+		default:
+			opts = append(opts, zeusapi.WithRetryMaxWait(wait))
+		}
+	}
+
+	if !data.RequestsPerSecond.IsNull() {
+		rps := data.RequestsPerSecond.ValueFloat64()
+		if rps < 0 {
+			diags.AddAttributeError(path.Root("requests_per_second"), "Invalid requests_per_second", "requests_per_second must not be negative.")
+		} else {
+			opts = append(opts, zeusapi.WithRateLimit(rps))
+		}
+	}
+
+	if !data.MaxConcurrentRequests.IsNull() {
+		maxConcurrent := data.MaxConcurrentRequests.ValueInt64()
+		if maxConcurrent < 0 {
+			diags.AddAttributeError(path.Root("max_concurrent_requests"), "Invalid max_concurrent_requests", "max_concurrent_requests must not be negative.")
+		} else {
+			opts = append(opts, zeusapi.WithMaxConcurrentRequests(int(maxConcurrent)))
+		}
+	}
+
+	return opts, diags
+}
+
 func (p *ZeusProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewPoolResource,
 		NewAssignResource,
 		NewPortResource,
+		NewRegionResource,
+		NewVMResource,
+		NewAssignIndexResource,
+		NewAssignRegionResource,
+		NewPoolReconcileResource,
 	}
 }
 
@@ -86,6 +272,16 @@ func (p *ZeusProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewPoolDataSource,
 		NewAssignDataSource,
 		NewPortDataSource,
+		NewRegionDataSource,
+		NewRegionsDataSource,
+		NewVMDataSource,
+		NewVMsDataSource,
+		NewPoolInfoDataSource,
+		NewPoolsDataSource,
+		NewImageDataSource,
+		NewPortsDataSource,
+		NewForwardRulesDataSource,
+		NewHealthDataSource,
 	}
 }
 
@@ -93,6 +289,8 @@ func (p *ZeusProvider) Functions(ctx context.Context) []func() function.Function
 	return []func() function.Function{
 		NewIPv4Long2IPFunction,
 		NewIPv4IP2LongFunction,
+		NewForwardRulesNftablesFunction,
+		NewForwardRulesIptablesFunction,
 	}
 }
 
@@ -0,0 +1,267 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Environment variables read when the provider block leaves a setting unset.
+const (
+	envEndpoint   = "ZEUS_ENDPOINT"
+	envToken      = "ZEUS_TOKEN"
+	envProfile    = "ZEUS_PROFILE"
+	envConfigFile = "ZEUS_CONFIG_FILE"
+
+	defaultProfile = "default"
+)
+
+// zeusProfile holds the settings of one section of the Zeus config file.
+type zeusProfile map[string]string
+
+// resolvedSetting is a provider setting together with where it came from.
+type resolvedSetting struct {
+	Value  string
+	Source string
+}
+
+// resolveConnectionSettings fills in endpoint and token from, in order, the
+// provider block, ZEUS_ENDPOINT/ZEUS_TOKEN and the selected profile of the
+// Zeus config file. Everything that could not be resolved is reported in a
+// single diagnostic.
+func resolveConnectionSettings(ctx context.Context, data ZeusProviderModel) (resolvedSetting, resolvedSetting, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// The profile and the config file only have to exist when they were
+	// asked for explicitly.
+	profileName, profileSource := defaultProfile, ""
+	switch {
+	case !data.Profile.IsNull():
+		profileName, profileSource = data.Profile.ValueString(), "provider block"
+	case os.Getenv(envProfile) != "":
+		profileName, profileSource = os.Getenv(envProfile), envProfile
+	}
+
+	configPath := os.Getenv(envConfigFile)
+	explicit := profileSource != "" || configPath != ""
+	if configPath == "" {
+		configPath = defaultConfigPath()
+	}
+
+	var profile zeusProfile
+	var profileProblem string
+	profiles, err := readConfigFile(configPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && !explicit:
+	case err != nil:
+		profileProblem = fmt.Sprintf("could not read config file %s: %s", configPath, err)
+	default:
+		var ok bool
+		profile, ok = profiles[profileName]
+		if !ok && profileSource != "" {
+			profileProblem = fmt.Sprintf("profile %q (from %s) not found in %s", profileName, profileSource, configPath)
+		}
+	}
+
+	fromProfile := fmt.Sprintf("profile %q in %s", profileName, configPath)
+	endpoint := resolveSetting(data.Endpoint.ValueString(), envEndpoint, profile["endpoint"], fromProfile)
+	var token resolvedSetting
+	switch {
+	case !data.TokenFile.IsNull():
+		token = resolvedSetting{Source: "token_file"}
+	case !data.TokenCommand.IsNull():
+		token = resolvedSetting{Source: "token_command"}
+	default:
+		token = resolveSetting(data.Token.ValueString(), envToken, profile["token"], fromProfile)
+	}
+
+	var missing []string
+	if endpoint.Value == "" {
+		missing = append(missing, fmt.Sprintf("endpoint: set it in the provider block, with %s, or as \"endpoint\" in profile %q of %s", envEndpoint, profileName, configPath))
+	}
+	if token.Value == "" && token.Source == "" {
+		missing = append(missing, fmt.Sprintf("token: set it, token_file or token_command in the provider block, with %s, or as \"token\" in profile %q of %s", envToken, profileName, configPath))
+	}
+	if len(missing) > 0 || (profileProblem != "" && explicit) {
+		detail := "The Zeus provider could not resolve its connection settings.\n"
+		for _, m := range missing {
+			detail += "\n- " + m
+		}
+		if profileProblem != "" {
+			detail += "\n- " + profileProblem
+		}
+		diags.AddError("Missing Zeus provider configuration", detail)
+		return endpoint, token, diags
+	}
+	if profileProblem != "" {
+		diags.AddWarning("Zeus config file ignored", profileProblem)
+	}
+
+	tflog.Info(ctx, "Resolved Zeus provider settings", map[string]any{
+		"endpoint":        endpoint.Value,
+		"endpoint_source": endpoint.Source,
+		"token_source":    token.Source,
+	})
+
+	return endpoint, token, diags
+}
+
+// configuredTokenSource returns the token source selected by token_file or
+// token_command, or nil when the token is a plain string.
+func configuredTokenSource(ctx context.Context, data ZeusProviderModel) (zeusapi.TokenSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var set []string
+	if !data.Token.IsNull() {
+		set = append(set, "token")
+	}
+	if !data.TokenFile.IsNull() {
+		set = append(set, "token_file")
+	}
+	if !data.TokenCommand.IsNull() {
+		set = append(set, "token_command")
+	}
+	if len(set) > 1 {
+		diags.AddError(
+			"Conflicting token configuration",
+			fmt.Sprintf("Only one of token, token_file and token_command may be set, got %s.", strings.Join(set, ", ")),
+		)
+		return nil, diags
+	}
+
+	switch {
+	case !data.TokenFile.IsNull():
+		if data.TokenFile.ValueString() == "" {
+			diags.AddAttributeError(path.Root("token_file"), "Invalid token_file", "token_file must not be empty.")
+			return nil, diags
+		}
+		return zeusapi.FileTokenSource(data.TokenFile.ValueString()), diags
+	case !data.TokenCommand.IsNull():
+		var argv []string
+		diags.Append(data.TokenCommand.ElementsAs(ctx, &argv, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		if len(argv) == 0 || argv[0] == "" {
+			diags.AddAttributeError(path.Root("token_command"), "Invalid token_command", "token_command must start with the program to run.")
+			return nil, diags
+		}
+		return zeusapi.CommandTokenSource(argv), diags
+	default:
+		return nil, diags
+	}
+}
+
+// tokenAttribute returns the provider attribute the token is configured with.
+func tokenAttribute(data ZeusProviderModel) string {
+	switch {
+	case !data.TokenFile.IsNull():
+		return "token_file"
+	case !data.TokenCommand.IsNull():
+		return "token_command"
+	default:
+		return "token"
+	}
+}
+
+func resolveSetting(configured, envName, profileValue, profileSource string) resolvedSetting {
+	switch {
+	case configured != "":
+		return resolvedSetting{Value: configured, Source: "provider block"}
+	case os.Getenv(envName) != "":
+		return resolvedSetting{Value: os.Getenv(envName), Source: envName}
+	case profileValue != "":
+		return resolvedSetting{Value: profileValue, Source: profileSource}
+	default:
+		return resolvedSetting{}
+	}
+}
+
+func defaultConfigPath() string {
+	if dir := os.Getenv("XDG_CONFIG_HOME"); dir != "" {
+		return filepath.Join(dir, "zeus", "config")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(".config", "zeus", "config")
+	}
+	return filepath.Join(home, ".config", "zeus", "config")
+}
+
+func readConfigFile(path string) (map[string]zeusProfile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return parseConfigFile(f)
+}
+
+// parseConfigFile reads an INI style file of [profile] sections holding
+// key = value pairs. Lines starting with # or ; are comments.
+func parseConfigFile(r io.Reader) (map[string]zeusProfile, error) {
+	profiles := make(map[string]zeusProfile)
+	var current zeusProfile
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: unterminated section header", lineNo)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty section name", lineNo)
+			}
+			current = profiles[name]
+			if current == nil {
+				current = make(zeusProfile)
+				profiles[name] = current
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: setting outside of a [profile] section", lineNo)
+		}
+		current[strings.TrimSpace(key)] = unquote(strings.TrimSpace(value))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+func unquote(value string) string {
+	if len(value) >= 2 {
+		if (value[0] == '"' && value[len(value)-1] == '"') || (value[0] == '\'' && value[len(value)-1] == '\'') {
+			return value[1 : len(value)-1]
+		}
+	}
+	return value
+}
@@ -0,0 +1,297 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testZeusConfigFile = `
+# Zeus CLI profiles
+[default]
+endpoint = https://zeus.example.com
+token    = "default-token"
+
+[staging]
+endpoint = 'https://zeus-staging.example.com'
+; token comes from ZEUS_TOKEN
+`
+
+func writeTestConfigFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config file: %v", err)
+	}
+	return path
+}
+
+// isolateZeusEnv clears every variable the provider reads so tests do not
+// pick up the settings of the machine running them.
+func isolateZeusEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{envEndpoint, envToken, envProfile, envConfigFile} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+}
+
+func TestParseConfigFile(t *testing.T) {
+	t.Parallel()
+
+	got, err := parseConfigFile(strings.NewReader(testZeusConfigFile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]zeusProfile{
+		"default": {"endpoint": "https://zeus.example.com", "token": "default-token"},
+		"staging": {"endpoint": "https://zeus-staging.example.com"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Fatalf("unexpected diff: %s", diff)
+	}
+
+	for name, content := range map[string]string{
+		"outside-section": "endpoint = x\n",
+		"unterminated":    "[default\n",
+		"missing-equals":  "[default]\nendpoint\n",
+	} {
+		if _, err := parseConfigFile(strings.NewReader(content)); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestResolveConnectionSettings(t *testing.T) {
+	testCases := map[string]struct {
+		data         ZeusProviderModel
+		env          map[string]string
+		noConfigFile bool
+		wantEndpoint resolvedSetting
+		wantToken    resolvedSetting
+		wantError    string
+	}{
+		"provider-block-wins": {
+			data: ZeusProviderModel{
+				Endpoint: types.StringValue("http://hcl"),
+				Token:    types.StringValue("hcl-token"),
+			},
+			env:          map[string]string{envEndpoint: "http://env", envToken: "env-token"},
+			wantEndpoint: resolvedSetting{Value: "http://hcl", Source: "provider block"},
+			wantToken:    resolvedSetting{Value: "hcl-token", Source: "provider block"},
+		},
+		"env-over-profile": {
+			env:          map[string]string{envEndpoint: "http://env"},
+			wantEndpoint: resolvedSetting{Value: "http://env", Source: envEndpoint},
+			wantToken:    resolvedSetting{Value: "default-token", Source: `profile "default" in CONFIG`},
+		},
+		"profile-from-env": {
+			env:          map[string]string{envProfile: "staging", envToken: "env-token"},
+			wantEndpoint: resolvedSetting{Value: "https://zeus-staging.example.com", Source: `profile "staging" in CONFIG`},
+			wantToken:    resolvedSetting{Value: "env-token", Source: envToken},
+		},
+		"profile-attribute-over-env": {
+			data:         ZeusProviderModel{Profile: types.StringValue("staging")},
+			env:          map[string]string{envProfile: "missing", envToken: "env-token"},
+			wantEndpoint: resolvedSetting{Value: "https://zeus-staging.example.com", Source: `profile "staging" in CONFIG`},
+			wantToken:    resolvedSetting{Value: "env-token", Source: envToken},
+		},
+		"token-file-replaces-token": {
+			data: ZeusProviderModel{
+				Endpoint:  types.StringValue("http://hcl"),
+				TokenFile: types.StringValue("/run/zeus/token"),
+			},
+			env:          map[string]string{envToken: "env-token"},
+			wantEndpoint: resolvedSetting{Value: "http://hcl", Source: "provider block"},
+			wantToken:    resolvedSetting{Source: "token_file"},
+		},
+		"missing-profile": {
+			data:      ZeusProviderModel{Profile: types.StringValue("prod"), Endpoint: types.StringValue("http://hcl"), Token: types.StringValue("t")},
+			wantError: `profile "prod" \(from provider block\) not found`,
+		},
+		"nothing-resolves": {
+			noConfigFile: true,
+			wantError:    `(?s)endpoint: set it.*ZEUS_ENDPOINT.*token: set it.*ZEUS_TOKEN.*could not read config file`,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			isolateZeusEnv(t)
+			configPath := filepath.Join(t.TempDir(), "missing")
+			if !tc.noConfigFile {
+				configPath = writeTestConfigFile(t, testZeusConfigFile)
+			}
+			t.Setenv(envConfigFile, configPath)
+			for key, value := range tc.env {
+				t.Setenv(key, value)
+			}
+
+			endpoint, token, diags := resolveConnectionSettings(context.Background(), tc.data)
+			if tc.wantError != "" {
+				if !diags.HasError() {
+					t.Fatal("expected an error")
+				}
+				if len(diags.Errors()) != 1 {
+					t.Fatalf("expected a single combined error, got %d", len(diags.Errors()))
+				}
+				if detail := diags.Errors()[0].Detail(); !regexp.MustCompile(tc.wantError).MatchString(detail) {
+					t.Fatalf("error detail %q does not match %q", detail, tc.wantError)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			tc.wantEndpoint.Source = strings.ReplaceAll(tc.wantEndpoint.Source, "CONFIG", configPath)
+			tc.wantToken.Source = strings.ReplaceAll(tc.wantToken.Source, "CONFIG", configPath)
+			if diff := cmp.Diff(tc.wantEndpoint, endpoint); diff != "" {
+				t.Errorf("unexpected endpoint: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantToken, token); diff != "" {
+				t.Errorf("unexpected token: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAccProviderConfigure_Fallback(t *testing.T) {
+	server := newTestServer(t, http.NotFoundHandler())
+
+	isolateZeusEnv(t)
+	t.Setenv(envConfigFile, writeTestConfigFile(t, "[ci]\nendpoint = "+server.URL+"\ntoken = token\n"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderFallbackConfig(""),
+				ExpectError: regexp.MustCompile(`Missing Zeus provider configuration`),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv(envEndpoint, server.URL)
+					t.Setenv(envToken, "token")
+				},
+				Config: testAccProviderFallbackConfig(""),
+				Check:  resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "true"),
+			},
+			{
+				PreConfig: func() {
+					t.Setenv(envEndpoint, "")
+					t.Setenv(envToken, "")
+				},
+				Config: testAccProviderFallbackConfig(`profile = "ci"`),
+				Check:  resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "true"),
+			},
+		},
+	})
+}
+
+func TestConfiguredTokenSource(t *testing.T) {
+	command := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("vault-token")})
+
+	source, diags := configuredTokenSource(context.Background(), ZeusProviderModel{Token: types.StringValue("t")})
+	if diags.HasError() || source != nil {
+		t.Fatalf("expected no token source for a plain token, got %v, %v", source, diags)
+	}
+
+	source, diags = configuredTokenSource(context.Background(), ZeusProviderModel{TokenCommand: command})
+	if diags.HasError() || source == nil {
+		t.Fatalf("expected a token source for token_command, got %v", diags)
+	}
+
+	_, diags = configuredTokenSource(context.Background(), ZeusProviderModel{
+		Token:        types.StringValue("t"),
+		TokenCommand: command,
+	})
+	if !diags.HasError() || diags.Errors()[0].Summary() != "Conflicting token configuration" {
+		t.Fatalf("expected a conflict error, got %v", diags)
+	}
+
+	_, diags = configuredTokenSource(context.Background(), ZeusProviderModel{
+		TokenCommand: types.ListValueMust(types.StringType, []attr.Value{}),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for an empty token_command")
+	}
+}
+
+func TestAccProviderConfigure_TokenSources(t *testing.T) {
+	server := newTestServer(t, http.NotFoundHandler())
+
+	isolateZeusEnv(t)
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("token\n"), 0o600); err != nil {
+		t.Fatalf("write token file: %v", err)
+	}
+
+	// The first token the command prints has already expired, so the client
+	// has to run it again after the 401.
+	marker := filepath.Join(dir, "issued")
+	command := `[ -e ` + marker + ` ] && echo token || { touch ` + marker + `; echo expired; }`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderFallbackConfig(`endpoint = "` + server.URL + `"
+  token      = "token"
+  token_file = "` + tokenFile + `"`),
+				ExpectError: regexp.MustCompile(`Conflicting token configuration`),
+			},
+			{
+				Config: testAccProviderFallbackConfig(`endpoint = "` + server.URL + `"
+  token_command = ["/bin/sh", "-c", "echo vault sealed >&2; exit 2"]`),
+				ExpectError: regexp.MustCompile(`(?s)Could not obtain Zeus token.*token_command.*vault sealed`),
+			},
+			{
+				Config: testAccProviderFallbackConfig(`endpoint = "` + server.URL + `"
+  skip_health_check = true
+  token_command     = ["/bin/sh", "-c", "exit 2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "false"),
+					resource.TestMatchResourceAttr("data.zeus_health.this", "error", regexp.MustCompile(`get token`)),
+				),
+			},
+			{
+				Config: testAccProviderFallbackConfig(`endpoint = "` + server.URL + `"
+  token_file = "` + tokenFile + `"`),
+				Check: resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "true"),
+			},
+			{
+				Config: testAccProviderFallbackConfig(`endpoint = "` + server.URL + `"
+  token_command = ["/bin/sh", "-c", "` + command + `"]`),
+				Check: resource.TestCheckResourceAttr("data.zeus_health.this", "authenticated", "true"),
+			},
+		},
+	})
+}
+
+func testAccProviderFallbackConfig(settings string) string {
+	return `
+provider "zeus" {
+  ` + settings + `
+}
+
+data "zeus_health" "this" {}
+`
+}
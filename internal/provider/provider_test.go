@@ -0,0 +1,202 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProviderConfigure_RetrySettings(t *testing.T) {
+	server := newTestServer(t, http.NotFoundHandler())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderRetryConfig(server.URL, "3", `"soon"`),
+				ExpectError: regexp.MustCompile(`Invalid retry_max_wait`),
+			},
+			{
+				Config:      testAccProviderRetryConfig(server.URL, "-1", `"5s"`),
+				ExpectError: regexp.MustCompile(`Invalid max_retries`),
+			},
+			{
+				Config: testAccProviderRetryConfig(server.URL, "0", `"5s"`),
+				Check:  resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "true"),
+			},
+		},
+	})
+}
+
+func testAccProviderRetryConfig(endpoint, maxRetries, retryMaxWait string) string {
+	return `
+provider "zeus" {
+  endpoint       = "` + endpoint + `"
+  token          = "token"
+  max_retries    = ` + maxRetries + `
+  retry_max_wait = ` + retryMaxWait + `
+}
+
+data "zeus_health" "this" {}
+`
+}
+
+func TestAccProviderConfigure_RateLimitSettings(t *testing.T) {
+	server := newTestServer(t, http.NotFoundHandler())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderRateLimitConfig(server.URL, "-1", "4"),
+				ExpectError: regexp.MustCompile(`Invalid requests_per_second`),
+			},
+			{
+				Config:      testAccProviderRateLimitConfig(server.URL, "10", "-4"),
+				ExpectError: regexp.MustCompile(`Invalid max_concurrent_requests`),
+			},
+			{
+				Config: testAccProviderRateLimitConfig(server.URL, "10", "4"),
+				Check:  resource.TestCheckResourceAttr("data.zeus_health.this", "healthy", "true"),
+			},
+		},
+	})
+}
+
+func testAccProviderRateLimitConfig(endpoint, requestsPerSecond, maxConcurrent string) string {
+	return `
+provider "zeus" {
+  endpoint                = "` + endpoint + `"
+  token                   = "token"
+  requests_per_second     = ` + requestsPerSecond + `
+  max_concurrent_requests = ` + maxConcurrent + `
+}
+
+data "zeus_health" "this" {}
+`
+}
+
+func TestProviderConfigure_UnknownConfig(t *testing.T) {
+	ctx := context.Background()
+	p := &ZeusProvider{}
+
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResp)
+	configType, ok := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	if !ok {
+		t.Fatal("expected the provider schema to be an object")
+	}
+
+	values := make(map[string]tftypes.Value, len(configType.AttributeTypes))
+	for name, typ := range configType.AttributeTypes {
+		values[name] = tftypes.NewValue(typ, nil)
+	}
+	values["endpoint"] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+	values["token"] = tftypes.NewValue(tftypes.String, "token")
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(configType, values)}
+
+	deferred := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{
+		Config:             config,
+		ClientCapabilities: provider.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+	}, deferred)
+	if deferred.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", deferred.Diagnostics)
+	}
+	if deferred.Deferred == nil || deferred.Deferred.Reason != provider.DeferredReasonProviderConfigUnknown {
+		t.Fatalf("expected the provider to defer, got %+v", deferred.Deferred)
+	}
+
+	lazy := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, lazy)
+	if lazy.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", lazy.Diagnostics)
+	}
+	client, ok := lazy.ResourceData.(*zeusapi.Client)
+	if !ok {
+		t.Fatalf("expected a *zeusapi.Client, got %T", lazy.ResourceData)
+	}
+	if _, err := client.ListRegions(ctx); !errors.Is(err, errProviderConfigUnknown) {
+		t.Fatalf("expected errProviderConfigUnknown, got %v", err)
+	}
+}
+
+func TestAccProviderConfigure_EndpointFromResource(t *testing.T) {
+	var mu sync.Mutex
+	var region *zeusapi.Region
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/regions":
+			var req zeusapi.CreateRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, "bad json", http.StatusBadRequest)
+				return
+			}
+			region = &zeusapi.Region{ID: "region-1", Name: req.Name, FriendlyName: req.FriendlyName, CreatedAt: "2024-01-01T00:00:00Z"}
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateRegionResponse{ID: region.ID})
+		case r.Method == http.MethodGet && r.URL.Path == "/regions":
+			regions := []zeusapi.Region{}
+			if region != nil {
+				regions = append(regions, *region)
+			}
+			_ = json.NewEncoder(w).Encode(regions)
+		case r.Method == http.MethodDelete && r.URL.Path == "/region/region-1":
+			region = nil
+			w.WriteHeader(http.StatusOK)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderEndpointFromResourceConfig(server.URL, `data "zeus_regions" "all" {}`),
+				ExpectError: regexp.MustCompile(`not known until\s+apply`),
+			},
+			{
+				Config: testAccProviderEndpointFromResourceConfig(server.URL, `
+resource "zeus_region" "test" {
+  name          = "us-east-1"
+  friendly_name = "US East"
+}
+`),
+				Check: resource.TestCheckResourceAttr("zeus_region.test", "id", "region-1"),
+			},
+		},
+	})
+}
+
+func testAccProviderEndpointFromResourceConfig(endpoint, body string) string {
+	return `
+resource "terraform_data" "endpoint" {
+  input = "` + endpoint + `"
+}
+
+provider "zeus" {
+  endpoint = terraform_data.endpoint.output
+  token    = "token"
+}
+
+` + body
+}
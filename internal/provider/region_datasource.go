@@ -0,0 +1,133 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RegionDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &RegionDataSource{}
+
+func NewRegionDataSource() datasource.DataSource {
+	return &RegionDataSource{}
+}
+
+type RegionDataSource struct {
+	client *zeusapi.Client
+}
+
+type regionDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (d *RegionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+func (d *RegionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a Zeus region by ID or name",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Region ID. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Region name. Exactly one of `id` or `name` must be set.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"friendly_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *RegionDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data regionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.ID.IsUnknown() || data.Name.IsUnknown() {
+		return
+	}
+
+	if data.ID.IsNull() == data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid region lookup",
+			"Exactly one of id or name must be set.",
+		)
+	}
+}
+
+func (d *RegionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *RegionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read regions failed", err.Error())
+		return
+	}
+
+	var found *zeusapi.Region
+	for i := range regions {
+		if !data.ID.IsNull() && regions[i].ID == data.ID.ValueString() ||
+			!data.Name.IsNull() && regions[i].Name == data.Name.ValueString() {
+			found = &regions[i]
+			break
+		}
+	}
+
+	if found == nil {
+		lookup := fmt.Sprintf("id %q", data.ID.ValueString())
+		if data.ID.IsNull() {
+			lookup = fmt.Sprintf("name %q", data.Name.ValueString())
+		}
+		resp.Diagnostics.AddError("Region not found", "No Zeus region matches "+lookup)
+		return
+	}
+
+	data.ID = types.StringValue(found.ID)
+	data.Name = types.StringValue(found.Name)
+	data.FriendlyName = types.StringValue(found.FriendlyName)
+	data.CreatedAt = types.StringValue(found.CreatedAt)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
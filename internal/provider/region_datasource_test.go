@@ -0,0 +1,97 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionDataSources(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/regions":
+			_ = json.NewEncoder(w).Encode([]zeusapi.Region{
+				{
+					ID:           "region-1",
+					Name:         "us-east-1",
+					FriendlyName: "US East",
+					CreatedAt:    "2024-01-01T00:00:00Z",
+				},
+				{
+					ID:           "region-2",
+					Name:         "eu-west-1",
+					FriendlyName: "EU West",
+					CreatedAt:    "2024-02-01T00:00:00Z",
+				},
+			})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionDataSourcesConfig(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.zeus_regions.all", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.zeus_regions.all", "regions.1.name", "eu-west-1"),
+					resource.TestCheckResourceAttr("data.zeus_region.by_name", "id", "region-2"),
+					resource.TestCheckResourceAttr("data.zeus_region.by_name", "friendly_name", "EU West"),
+					resource.TestCheckResourceAttr("data.zeus_region.by_id", "name", "us-east-1"),
+					resource.TestCheckResourceAttr("data.zeus_region.by_id", "created_at", "2024-01-01T00:00:00Z"),
+				),
+			},
+			{
+				Config:      testAccRegionDataSourceMissingConfig(server.URL),
+				ExpectError: regexp.MustCompile(`No Zeus region matches name "ap-south-1"`),
+			},
+		},
+	})
+}
+
+func testAccRegionDataSourcesConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_regions" "all" {}
+
+data "zeus_region" "by_name" {
+  name = "eu-west-1"
+}
+
+data "zeus_region" "by_id" {
+  id = "region-1"
+}
+`
+}
+
+func testAccRegionDataSourceMissingConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_region" "missing" {
+  name = "ap-south-1"
+}
+`
+}
@@ -0,0 +1,217 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &RegionResource{}
+var _ resource.ResourceWithImportState = &RegionResource{}
+
+func NewRegionResource() resource.Resource {
+	return &RegionResource{}
+}
+
+type RegionResource struct {
+	client *zeusapi.Client
+}
+
+type regionModel struct {
+	ID           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	FriendlyName types.String `tfsdk:"friendly_name"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+}
+
+func (r *RegionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_region"
+}
+
+func (r *RegionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Zeus region",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Unique region name",
+				Required:            true,
+			},
+			"friendly_name": schema.StringAttribute{
+				MarkdownDescription: "Human readable region name",
+				Required:            true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RegionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *RegionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan regionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateRegion(ctx, zeusapi.CreateRegionRequest{
+		Name:         plan.Name.ValueString(),
+		FriendlyName: plan.FriendlyName.ValueString(),
+	})
+	if err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.Conflict() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Region name conflict",
+				fmt.Sprintf("A region named %q already exists in Zeus. Import it with terraform import or choose a different name: %s", plan.Name.ValueString(), err),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Create region failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(createResp.ID)
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read region after create failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RegionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state regionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read region failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *RegionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state regionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var patch zeusapi.UpdateRegionRequest
+	if !plan.Name.Equal(state.Name) {
+		name := plan.Name.ValueString()
+		patch.Name = &name
+	}
+	if !plan.FriendlyName.Equal(state.FriendlyName) {
+		friendlyName := plan.FriendlyName.ValueString()
+		patch.FriendlyName = &friendlyName
+	}
+
+	region, err := r.client.UpdateRegion(ctx, state.ID.ValueString(), patch)
+	if err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.Conflict() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Region name conflict",
+				fmt.Sprintf("Cannot rename region %s to %q because another region already uses that name: %s", state.ID.ValueString(), plan.Name.ValueString(), err),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Update region failed", err.Error())
+		return
+	}
+
+	plan.ID = state.ID
+	applyRegion(&plan, region)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RegionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state regionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteRegion(ctx, state.ID.ValueString()); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return
+		}
+		if errors.As(err, &apiErr) && apiErr.Conflict() {
+			resp.Diagnostics.AddError(
+				"Region still has pools",
+				fmt.Sprintf("Region %q (%s) cannot be deleted while pools still belong to it. Delete its zeus_pool resources first: %s", state.Name.ValueString(), state.ID.ValueString(), err),
+			)
+			return
+		}
+		resp.Diagnostics.AddError("Delete region failed", err.Error())
+	}
+}
+
+func (r *RegionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *RegionResource) refresh(ctx context.Context, m *regionModel) error {
+	region, err := r.client.GetRegion(ctx, m.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	applyRegion(m, region)
+	return nil
+}
+
+func applyRegion(m *regionModel, region zeusapi.Region) {
+	m.Name = types.StringValue(region.Name)
+	m.FriendlyName = types.StringValue(region.FriendlyName)
+	m.CreatedAt = types.StringValue(region.CreatedAt)
+}
@@ -0,0 +1,199 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionResource(t *testing.T) {
+	var mu sync.Mutex
+	var region *zeusapi.Region
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/regions":
+			var req zeusapi.CreateRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			region = &zeusapi.Region{
+				ID:           "region-1",
+				Name:         req.Name,
+				FriendlyName: req.FriendlyName,
+				CreatedAt:    "2024-01-01T00:00:00Z",
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateRegionResponse{ID: region.ID})
+		case r.Method == http.MethodGet && r.URL.Path == "/regions":
+			regions := []zeusapi.Region{}
+			if region != nil {
+				regions = append(regions, *region)
+			}
+			_ = json.NewEncoder(w).Encode(regions)
+		case r.Method == http.MethodPatch && r.URL.Path == "/region/region-1":
+			var req zeusapi.UpdateRegionRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Name != nil {
+				region.Name = *req.Name
+			}
+			if req.FriendlyName != nil {
+				region.FriendlyName = *req.FriendlyName
+			}
+			_ = json.NewEncoder(w).Encode(region)
+		case r.Method == http.MethodDelete && r.URL.Path == "/region/region-1":
+			region = nil
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionConfig(server.URL, "us-east-1", "US East"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_region.test", "id", "region-1"),
+					resource.TestCheckResourceAttr("zeus_region.test", "name", "us-east-1"),
+					resource.TestCheckResourceAttr("zeus_region.test", "friendly_name", "US East"),
+					resource.TestCheckResourceAttr("zeus_region.test", "created_at", "2024-01-01T00:00:00Z"),
+				),
+			},
+			{
+				Config: testAccRegionConfig(server.URL, "us-east-2", "US East 2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_region.test", "id", "region-1"),
+					resource.TestCheckResourceAttr("zeus_region.test", "name", "us-east-2"),
+					resource.TestCheckResourceAttr("zeus_region.test", "friendly_name", "US East 2"),
+				),
+			},
+			{
+				ResourceName:      "zeus_region.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccRegionResource_NameConflict(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/regions":
+			w.WriteHeader(http.StatusConflict)
+			_ = json.NewEncoder(w).Encode(map[string]any{"error": "region name already exists"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config:      testAccRegionConfig(server.URL, "us-east-1", "US East"),
+			ExpectError: regexp.MustCompile(`Region name conflict`),
+		}},
+	})
+}
+
+func TestAccRegionResource_DeleteWithPools(t *testing.T) {
+	var mu sync.Mutex
+	hasPools := true
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/regions":
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateRegionResponse{ID: "region-busy"})
+		case r.Method == http.MethodGet && r.URL.Path == "/regions":
+			_ = json.NewEncoder(w).Encode([]zeusapi.Region{{
+				ID:           "region-busy",
+				Name:         "us-east-1",
+				FriendlyName: "US East",
+				CreatedAt:    "2024-01-01T00:00:00Z",
+			}})
+		case r.Method == http.MethodDelete && r.URL.Path == "/region/region-busy":
+			if hasPools {
+				hasPools = false
+				w.WriteHeader(http.StatusConflict)
+				_ = json.NewEncoder(w).Encode(map[string]any{"error": "region still has pools"})
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionConfig(server.URL, "us-east-1", "US East"),
+			},
+			{
+				Config:      testAccRegionProviderOnlyConfig(server.URL),
+				ExpectError: regexp.MustCompile(`Region still has pools`),
+			},
+		},
+	})
+}
+
+func testAccRegionConfig(endpoint, name, friendlyName string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_region" "test" {
+  name          = "` + name + `"
+  friendly_name = "` + friendlyName + `"
+}
+`
+}
+
+func testAccRegionProviderOnlyConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+`
+}
@@ -0,0 +1,90 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &RegionsDataSource{}
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &RegionsDataSource{}
+}
+
+type RegionsDataSource struct {
+	client *zeusapi.Client
+}
+
+type regionsDataSourceModel struct {
+	Regions []regionDataSourceModel `tfsdk:"regions"`
+}
+
+func (d *RegionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *RegionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all Zeus regions",
+		Attributes: map[string]schema.Attribute{
+			"regions": schema.ListNestedAttribute{
+				MarkdownDescription: "Regions known to Zeus",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":            schema.StringAttribute{Computed: true},
+						"name":          schema.StringAttribute{Computed: true},
+						"friendly_name": schema.StringAttribute{Computed: true},
+						"created_at":    schema.StringAttribute{Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RegionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *RegionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data regionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	regions, err := d.client.ListRegions(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Read regions failed", err.Error())
+		return
+	}
+
+	data.Regions = make([]regionDataSourceModel, 0, len(regions))
+	for _, region := range regions {
+		data.Regions = append(data.Regions, regionDataSourceModel{
+			ID:           types.StringValue(region.ID),
+			Name:         types.StringValue(region.Name),
+			FriendlyName: types.StringValue(region.FriendlyName),
+			CreatedAt:    types.StringValue(region.CreatedAt),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
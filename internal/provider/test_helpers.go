@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"encoding/json"
 	"net"
 	"net/http"
 	"net/http/httptest"
@@ -23,7 +24,30 @@ func TestAccPreCheck(t *testing.T) {
 	// Placeholder for required environment assertions.
 }
 
+// newTestServer starts a fake Zeus API that answers the health and connection
+// checks made during provider Configure and passes every other request to
+// handler. The connection check accepts the token "token".
 func newTestServer(t *testing.T, handler http.Handler) *httptest.Server {
+	t.Helper()
+	return newRawTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/_internal/health":
+			_ = json.NewEncoder(w).Encode(map[string]any{"message": "ok"})
+		case r.Method == http.MethodGet && r.URL.Path == "/connection/check":
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				_ = json.NewEncoder(w).Encode(map[string]any{"message": "Unauthorized"})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{"ok": true})
+		default:
+			handler.ServeHTTP(w, r)
+		}
+	}))
+}
+
+// newRawTestServer starts a fake Zeus API that passes every request to handler.
+func newRawTestServer(t *testing.T, handler http.Handler) *httptest.Server {
 	t.Helper()
 	ln, err := net.Listen("tcp4", "127.0.0.1:0")
 	if err != nil {
@@ -0,0 +1,110 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// tlsConfigured reports whether any TLS setting is set, in which case the
+// client gets its own transport instead of http.DefaultClient.
+func tlsConfigured(data ZeusProviderModel) bool {
+	return !data.CACertPEM.IsNull() ||
+		!data.CACertFile.IsNull() ||
+		!data.ClientCert.IsNull() ||
+		!data.ClientKey.IsNull() ||
+		!data.TLSServerName.IsNull() ||
+		data.InsecureSkipVerify.ValueBool()
+}
+
+// buildHTTPClient returns an HTTP client with a dedicated transport carrying
+// the TLS settings of the provider block, or nil when none are set.
+func buildHTTPClient(data ZeusProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !tlsConfigured(data) {
+		return nil, diags
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         data.TLSServerName.ValueString(),
+		InsecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+	}
+
+	if !data.CACertPEM.IsNull() && !data.CACertFile.IsNull() {
+		diags.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Conflicting CA configuration",
+			"Only one of ca_cert_pem and ca_cert_file may be set.",
+		)
+		return nil, diags
+	}
+
+	caAttr := path.Root("ca_cert_pem")
+	var caPEM []byte
+	switch {
+	case !data.CACertPEM.IsNull():
+		caPEM = []byte(data.CACertPEM.ValueString())
+	case !data.CACertFile.IsNull():
+		caAttr = path.Root("ca_cert_file")
+		b, err := os.ReadFile(data.CACertFile.ValueString())
+		if err != nil {
+			diags.AddAttributeError(caAttr, "Invalid ca_cert_file", fmt.Sprintf("Could not read CA bundle: %s", err))
+			return nil, diags
+		}
+		caPEM = b
+	}
+	if caPEM != nil {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM(caPEM) {
+			diags.AddAttributeError(
+				caAttr,
+				"Invalid "+caAttr.String(),
+				"The CA bundle does not contain any PEM encoded certificate. Expected one or more \"-----BEGIN CERTIFICATE-----\" blocks.",
+			)
+			return nil, diags
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if data.ClientCert.IsNull() != data.ClientKey.IsNull() {
+		diags.AddAttributeError(
+			path.Root("client_key"),
+			"Incomplete client certificate",
+			"client_cert and client_key must be set together.",
+		)
+		return nil, diags
+	}
+	if !data.ClientCert.IsNull() {
+		cert, err := tls.X509KeyPair([]byte(data.ClientCert.ValueString()), []byte(data.ClientKey.ValueString()))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid client certificate",
+				fmt.Sprintf("Could not load client_cert and client_key as a PEM encoded key pair: %s", err),
+			)
+			return nil, diags
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		diags.AddError("Invalid client configuration", "http.DefaultTransport is not an *http.Transport")
+		return nil, diags
+	}
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+	return &http.Client{Transport: transport}, diags
+}
@@ -0,0 +1,113 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VMDataSource{}
+
+func NewVMDataSource() datasource.DataSource {
+	return &VMDataSource{}
+}
+
+type VMDataSource struct {
+	client *zeusapi.Client
+}
+
+type vmDataSourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	Host      types.String `tfsdk:"host"`
+	VMID      types.Int64  `tfsdk:"vmid"`
+	Name      types.String `tfsdk:"name"`
+	Type      types.String `tfsdk:"type"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Leases    types.Map    `tfsdk:"leases"`
+}
+
+func (d *VMDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (d *VMDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lookup a Zeus VM by host and vmid",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor host running the VM",
+				Required:            true,
+			},
+			"vmid": schema.Int64Attribute{
+				MarkdownDescription: "Numeric VM ID on the host",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal Zeus VM ID",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				Computed: true,
+			},
+			"type": schema.StringAttribute{
+				Computed: true,
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"leases": schema.MapAttribute{
+				Computed:    true,
+				ElementType: leaseAttrType(),
+			},
+		},
+	}
+}
+
+func (d *VMDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *VMDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vmDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vm, err := d.client.GetVM(ctx, data.Host.ValueString(), data.VMID.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Read VM failed", err.Error())
+		return
+	}
+
+	data = encodeVMInfo(vm)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func encodeVMInfo(vm zeusapi.VMInfo) vmDataSourceModel {
+	return vmDataSourceModel{
+		ID:        types.StringValue(vm.ID),
+		Host:      types.StringValue(vm.Host),
+		VMID:      types.Int64Value(vm.VMID),
+		Name:      types.StringValue(vm.Name),
+		Type:      types.StringValue(vm.Type),
+		CreatedAt: types.StringValue(vm.CreatedAt),
+		Leases:    encodeLeases(vm.Leases),
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccVMDataSources(t *testing.T) {
+	vlan := int64(42)
+	web := zeusapi.VMInfo{
+		ID:        "vm-1",
+		CreatedAt: "2024-01-01T00:00:00Z",
+		Host:      "pve-1",
+		Name:      "web-1",
+		VMID:      101,
+		Type:      "kvm",
+		Leases: map[string]zeusapi.AddressResult{
+			"us-east-1": {
+				Address: "10.0.0.5",
+				Gateway: "10.0.0.254",
+				LeaseID: "lease-1",
+				VLAN:    &vlan,
+			},
+		},
+	}
+	db := zeusapi.VMInfo{
+		ID:        "vm-2",
+		CreatedAt: "2024-01-02T00:00:00Z",
+		Host:      "pve-1",
+		Name:      "db-1",
+		VMID:      102,
+		Type:      "lxc",
+	}
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/vm/pve-1/101":
+			_ = json.NewEncoder(w).Encode(web)
+		case r.Method == http.MethodGet && r.URL.Path == "/vm/pve-1":
+			_ = json.NewEncoder(w).Encode([]zeusapi.VMInfo{web, db})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{{
+			Config: testAccVMDataSourcesConfig(server.URL),
+			Check: resource.ComposeTestCheckFunc(
+				resource.TestCheckResourceAttr("data.zeus_vm.web", "id", "vm-1"),
+				resource.TestCheckResourceAttr("data.zeus_vm.web", "name", "web-1"),
+				resource.TestCheckResourceAttr("data.zeus_vm.web", "leases.us-east-1.address", "10.0.0.5"),
+				resource.TestCheckResourceAttr("data.zeus_vm.web", "leases.us-east-1.vlan", "42"),
+				resource.TestCheckResourceAttr("data.zeus_vms.all", "vms.#", "2"),
+				resource.TestCheckResourceAttr("data.zeus_vms.all", "vms.0.leases.us-east-1.gateway", "10.0.0.254"),
+				resource.TestCheckResourceAttr("data.zeus_vms.all", "vms.1.vmid", "102"),
+				resource.TestCheckNoResourceAttr("data.zeus_vms.all", "vms.1.leases.%"),
+			),
+		}},
+	})
+}
+
+func testAccVMDataSourcesConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+data "zeus_vm" "web" {
+  host = "pve-1"
+  vmid = 101
+}
+
+data "zeus_vms" "all" {
+  host = "pve-1"
+}
+`
+}
@@ -0,0 +1,177 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Migration steps recorded in zeus_vm state so an interrupted host/vmid
+// change can resume where it stopped instead of starting over.
+const (
+	vmMigrationPlaceholderCreated = "placeholder_created"
+	vmMigrationSourceReleased     = "source_released"
+)
+
+type vmMigrationProgress struct {
+	PlaceholderID string
+	Host          string
+	VMID          int64
+	Step          string
+}
+
+func vmMigrationAttrType() types.ObjectType {
+	return types.ObjectType{
+		AttrTypes: map[string]attr.Type{
+			"placeholder_id": types.StringType,
+			"host":           types.StringType,
+			"vmid":           types.Int64Type,
+			"step":           types.StringType,
+		},
+	}
+}
+
+func encodeVMMigration(progress *vmMigrationProgress) types.Object {
+	if progress == nil {
+		return types.ObjectNull(vmMigrationAttrType().AttrTypes)
+	}
+
+	return types.ObjectValueMust(
+		vmMigrationAttrType().AttrTypes,
+		map[string]attr.Value{
+			"placeholder_id": types.StringValue(progress.PlaceholderID),
+			"host":           types.StringValue(progress.Host),
+			"vmid":           types.Int64Value(progress.VMID),
+			"step":           types.StringValue(progress.Step),
+		},
+	)
+}
+
+func decodeVMMigration(v types.Object) *vmMigrationProgress {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	attrs := v.Attributes()
+	placeholderID, _ := attrs["placeholder_id"].(types.String)
+	host, _ := attrs["host"].(types.String)
+	vmid, _ := attrs["vmid"].(types.Int64)
+	step, _ := attrs["step"].(types.String)
+	return &vmMigrationProgress{
+		PlaceholderID: placeholderID.ValueString(),
+		Host:          host.ValueString(),
+		VMID:          vmid.ValueInt64(),
+		Step:          step.ValueString(),
+	}
+}
+
+// leaseSlot works out the pools and the shared pool index that hold a set of
+// leases. POST /vms/migration needs them to reserve the same addresses for
+// the placeholder on the destination host, and an imported zeus_assign_index
+// needs them to rebuild its pools and index.
+func leaseSlot(ctx context.Context, client *zeusapi.Client, leases map[string]zeusapi.AddressResult) ([]string, int64, error) {
+	regions := make([]string, 0, len(leases))
+	for region := range leases {
+		regions = append(regions, region)
+	}
+	sort.Strings(regions)
+
+	pools := make([]string, 0, len(regions))
+	var index int64
+	var indexRegion string
+	for _, region := range regions {
+		address := leases[region].Address
+		poolID, poolIndex, err := findLeasePool(ctx, client, region, address)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		if indexRegion != "" && poolIndex != index {
+			return nil, 0, fmt.Errorf(
+				"leases use different pool indexes (%d in region %q, %d in region %q); a single shared index is required",
+				index, indexRegion, poolIndex, region,
+			)
+		}
+		index = poolIndex
+		indexRegion = region
+		pools = append(pools, poolID)
+	}
+
+	return pools, index, nil
+}
+
+func findLeasePool(ctx context.Context, client *zeusapi.Client, region, address string) (string, int64, error) {
+	addr, err := ipv4IPToLong(address)
+	if err != nil {
+		return "", 0, fmt.Errorf("lease address %q in region %q: %w", address, region, err)
+	}
+
+	pools, err := client.ListPoolsByRegion(ctx, region)
+	if err != nil {
+		return "", 0, fmt.Errorf("list pools in region %q: %w", region, err)
+	}
+
+	for _, pool := range pools {
+		begin, err := ipv4IPToLong(pool.Begin)
+		if err != nil {
+			continue
+		}
+		end, err := ipv4IPToLong(pool.End)
+		if err != nil {
+			continue
+		}
+		if addr >= begin && addr <= end {
+			return pool.ID, addr - begin, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("no pool in region %q contains lease address %s", region, address)
+}
+
+func leaseAddressesChanged(before, after map[string]zeusapi.AddressResult) bool {
+	if len(before) != len(after) {
+		return true
+	}
+	for region, lease := range before {
+		if after[region].Address != lease.Address {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeLeases(m types.Map) map[string]zeusapi.AddressResult {
+	if m.IsNull() || m.IsUnknown() {
+		return nil
+	}
+
+	leases := make(map[string]zeusapi.AddressResult, len(m.Elements()))
+	for region, value := range m.Elements() {
+		obj, ok := value.(types.Object)
+		if !ok {
+			continue
+		}
+		attrs := obj.Attributes()
+		address, _ := attrs["address"].(types.String)
+		gateway, _ := attrs["gateway"].(types.String)
+		leaseID, _ := attrs["lease_id"].(types.String)
+		lease := zeusapi.AddressResult{
+			Address: address.ValueString(),
+			Gateway: gateway.ValueString(),
+			LeaseID: leaseID.ValueString(),
+		}
+		if vlan, ok := attrs["vlan"].(types.Int64); ok && !vlan.IsNull() {
+			v := vlan.ValueInt64()
+			lease.VLAN = &v
+		}
+		leases[region] = lease
+	}
+	return leases
+}
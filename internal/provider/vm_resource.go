@@ -0,0 +1,412 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &VMResource{}
+var _ resource.ResourceWithImportState = &VMResource{}
+var _ resource.ResourceWithModifyPlan = &VMResource{}
+
+func NewVMResource() resource.Resource {
+	return &VMResource{}
+}
+
+type VMResource struct {
+	client *zeusapi.Client
+}
+
+type vmModel struct {
+	ID        types.String `tfsdk:"id"`
+	Region    types.List   `tfsdk:"region"`
+	Host      types.String `tfsdk:"host"`
+	Name      types.String `tfsdk:"name"`
+	VMID      types.Int64  `tfsdk:"vmid"`
+	Type      types.String `tfsdk:"type"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	Leases    types.Map    `tfsdk:"leases"`
+	Migration types.Object `tfsdk:"migration"`
+}
+
+func (r *VMResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vm"
+}
+
+func (r *VMResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Zeus virtual machine with addresses allocated across regions",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Internal Zeus VM ID",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"region": schema.ListAttribute{
+				MarkdownDescription: "Regions to allocate in. An imported VM takes the order of the configured list without being replaced.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.List{
+					requiresReplaceUnlessImportedList(),
+				},
+			},
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor host running the VM. Changing it migrates the VM in place and keeps its leases.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "VM name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"vmid": schema.Int64Attribute{
+				MarkdownDescription: "Numeric VM ID on the host. Changing it migrates the VM in place and keeps its leases.",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type tag",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed: true,
+			},
+			"leases": schema.MapAttribute{
+				Computed:    true,
+				ElementType: leaseAttrType(),
+			},
+			"migration": schema.ObjectAttribute{
+				MarkdownDescription: "Progress of an interrupted host/vmid migration. `placeholder_id` is the VM ID created on the destination `host`/`vmid` and `step` is the last completed step (`placeholder_created` or `source_released`). Null when no migration is pending; the next apply resumes from the recorded step. Setting `host` and `vmid` back to the current values before the source is released cancels the migration and deletes the placeholder.",
+				Computed:            true,
+				AttributeTypes:      vmMigrationAttrType().AttrTypes,
+			},
+		},
+	}
+}
+
+func (r *VMResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	r.client = client
+}
+
+func (r *VMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan vmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var regions []string
+	resp.Diagnostics.Append(plan.Region.ElementsAs(ctx, &regions, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createResp, err := r.client.CreateVM(ctx, zeusapi.CreateVMRequest{
+		Region: regions,
+		Host:   plan.Host.ValueString(),
+		Name:   plan.Name.ValueString(),
+		VMID:   plan.VMID.ValueInt64(),
+		Type:   plan.Type.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Create VM failed", err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(createResp.ID)
+	plan.Migration = encodeVMMigration(nil)
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read VM after create failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VMResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state vmModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.refresh(ctx, &state); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			if decodeVMMigration(state.Migration) != nil {
+				// The source VM may already be marked as migrating out; keep
+				// the recorded progress so the next apply can finish the move.
+				return
+			}
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Read VM failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *VMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state vmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Host.Equal(state.Host) || !plan.VMID.Equal(state.VMID) || decodeVMMigration(state.Migration) != nil {
+		r.migrate(ctx, plan, &state, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	plan.Migration = encodeVMMigration(nil)
+	if err := r.refresh(ctx, &plan); err != nil {
+		resp.Diagnostics.AddError("Read VM after update failed", err.Error())
+		return
+	}
+
+	if leaseAddressesChanged(decodeLeases(state.Leases), decodeLeases(plan.Leases)) {
+		resp.Diagnostics.AddWarning(
+			"VM addresses changed during migration",
+			fmt.Sprintf("Zeus returned different lease addresses for VM %s after migrating it to %s/%d. Review dependent DNS and firewall configuration.", plan.Name.ValueString(), plan.Host.ValueString(), plan.VMID.ValueInt64()),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, nil)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *VMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state vmModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A migration completes on the destination placeholder, so the internal
+	// VM ID changes along with host/vmid.
+	if !plan.Host.Equal(state.Host) || !plan.VMID.Equal(state.VMID) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+	}
+
+	// A pending migration needs an apply even when host/vmid were set back
+	// to the source, so that the placeholder gets cleaned up.
+	if !state.Migration.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("migration"), types.ObjectUnknown(vmMigrationAttrType().AttrTypes))...)
+	}
+}
+
+// migrate moves the VM in state to the planned host/vmid using the Zeus
+// three-step migration flow. Progress is written to state after every step
+// so a failed apply resumes from the last completed step.
+func (r *VMResource) migrate(ctx context.Context, plan vmModel, state *vmModel, resp *resource.UpdateResponse) {
+	progress := decodeVMMigration(state.Migration)
+
+	if progress != nil && plan.Host.Equal(state.Host) && plan.VMID.Equal(state.VMID) {
+		r.cancelMigration(ctx, progress, state, resp)
+		return
+	}
+
+	if progress == nil {
+		pools, index, err := leaseSlot(ctx, r.client, decodeLeases(state.Leases))
+		if err != nil {
+			resp.Diagnostics.AddError("Prepare VM migration failed", err.Error())
+			return
+		}
+
+		placeholder, err := r.client.CreateVMMigration(ctx, zeusapi.VMMigrationRequest{
+			Host:  plan.Host.ValueString(),
+			VMID:  plan.VMID.ValueInt64(),
+			Pools: pools,
+			Index: index,
+			Type:  state.Type.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Create VM migration placeholder failed", err.Error())
+			return
+		}
+
+		progress = &vmMigrationProgress{
+			PlaceholderID: placeholder.ID,
+			Host:          plan.Host.ValueString(),
+			VMID:          plan.VMID.ValueInt64(),
+			Step:          vmMigrationPlaceholderCreated,
+		}
+		state.Migration = encodeVMMigration(progress)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	}
+
+	if progress.Step == vmMigrationPlaceholderCreated {
+		if _, err := r.client.MigrateOutVM(ctx, state.Host.ValueString(), state.VMID.ValueInt64()); err != nil {
+			resp.Diagnostics.AddError(
+				"Mark VM as migrating out failed",
+				fmt.Sprintf("Placeholder %s was created on %s/%d; the next apply will retry from this step: %s", progress.PlaceholderID, plan.Host.ValueString(), plan.VMID.ValueInt64(), err),
+			)
+			return
+		}
+
+		progress.Step = vmMigrationSourceReleased
+		state.Migration = encodeVMMigration(progress)
+		resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	}
+
+	vm, err := r.client.MigrateInVM(ctx, progress.PlaceholderID, zeusapi.MigrateInRequest{
+		Host: plan.Host.ValueString(),
+		VMID: plan.VMID.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Complete VM migration failed",
+			fmt.Sprintf("VM was released from %s/%d; the next apply will retry completing it on %s/%d: %s", state.Host.ValueString(), state.VMID.ValueInt64(), plan.Host.ValueString(), plan.VMID.ValueInt64(), err),
+		)
+		return
+	}
+
+	state.ID = types.StringValue(vm.ID)
+}
+
+// cancelMigration drops a pending migration after host/vmid were set back to
+// the source. Only the placeholder has to go while the source still holds the
+// VM; once the source was released the move can only be completed.
+func (r *VMResource) cancelMigration(ctx context.Context, progress *vmMigrationProgress, state *vmModel, resp *resource.UpdateResponse) {
+	if progress.Step != vmMigrationPlaceholderCreated {
+		resp.Diagnostics.AddError(
+			"Cancel VM migration failed",
+			fmt.Sprintf("VM was already released from %s/%d for the migration to %s/%d. Set host and vmid to %s and %d to complete the migration.", state.Host.ValueString(), state.VMID.ValueInt64(), progress.Host, progress.VMID, progress.Host, progress.VMID),
+		)
+		return
+	}
+
+	if err := r.client.DeleteVM(ctx, progress.Host, progress.VMID); err != nil {
+		var apiErr *zeusapi.APIError
+		if !errors.As(err, &apiErr) || !apiErr.NotFound() {
+			resp.Diagnostics.AddError(
+				"Delete VM migration placeholder failed",
+				fmt.Sprintf("Placeholder %s on %s/%d is still reserved; the next apply will retry deleting it: %s", progress.PlaceholderID, progress.Host, progress.VMID, err),
+			)
+			return
+		}
+	}
+
+	state.Migration = encodeVMMigration(nil)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *VMResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state vmModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteVM(ctx, state.Host.ValueString(), state.VMID.ValueInt64()); err != nil {
+		var apiErr *zeusapi.APIError
+		if errors.As(err, &apiErr) && apiErr.NotFound() {
+			return
+		}
+		resp.Diagnostics.AddError("Delete VM failed", err.Error())
+	}
+}
+
+func (r *VMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	host, vmid, err := parseVMImportID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("host"), host)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vmid"), vmid)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateKey, importedPrivateValue)...)
+}
+
+func (r *VMResource) refresh(ctx context.Context, m *vmModel) error {
+	vm, err := r.client.GetVM(ctx, m.Host.ValueString(), m.VMID.ValueInt64())
+	if err != nil {
+		return err
+	}
+
+	m.ID = types.StringValue(vm.ID)
+	m.Host = types.StringValue(vm.Host)
+	m.Name = types.StringValue(vm.Name)
+	m.VMID = types.Int64Value(vm.VMID)
+	m.Type = types.StringValue(vm.Type)
+	m.CreatedAt = types.StringValue(vm.CreatedAt)
+	m.Leases = encodeLeases(vm.Leases)
+
+	// Imported VMs have no region yet. Zeus allocates one lease per region,
+	// so the lease keys are the regions the VM was created in. The next apply
+	// takes the configured order, see requiresReplaceUnlessImportedList.
+	if m.Region.IsNull() {
+		m.Region = leaseRegions(vm.Leases)
+	}
+	return nil
+}
+
+func leaseRegions(leases map[string]zeusapi.AddressResult) types.List {
+	names := make([]string, 0, len(leases))
+	for region := range leases {
+		names = append(names, region)
+	}
+	sort.Strings(names)
+
+	regions := make([]attr.Value, 0, len(names))
+	for _, region := range names {
+		regions = append(regions, types.StringValue(region))
+	}
+	return types.ListValueMust(types.StringType, regions)
+}
+
+func parseVMImportID(id string) (string, int64, error) {
+	host, rawVMID, ok := strings.Cut(id, "/")
+	if !ok || host == "" || rawVMID == "" {
+		return "", 0, fmt.Errorf("expected import ID in the form host/vmid, got %q", id)
+	}
+
+	vmid, err := strconv.ParseInt(rawVMID, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("vmid in import ID %q must be an integer", id)
+	}
+	return host, vmid, nil
+}
@@ -0,0 +1,431 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccVMResource(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/vms":
+			var req zeusapi.CreateVMRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			if req.Host != "pve-1" || req.VMID != 101 || req.Name != "web-1" || req.Type != "kvm" {
+				http.Error(w, "unexpected request payload", http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateVMResponse{
+				ID: "vm-1",
+				Addresses: map[string]zeusapi.AddressResult{
+					"us-east-1": {
+						Address: "10.0.0.5",
+						Gateway: "10.0.0.254",
+						LeaseID: "lease-1",
+					},
+				},
+			})
+		case r.Method == http.MethodGet && r.URL.Path == "/vm/pve-1/101":
+			_ = json.NewEncoder(w).Encode(zeusapi.VMInfo{
+				ID:        "vm-1",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				Host:      "pve-1",
+				Name:      "web-1",
+				VMID:      101,
+				Type:      "kvm",
+				Leases: map[string]zeusapi.AddressResult{
+					"us-east-1": {
+						Address: "10.0.0.5",
+						Gateway: "10.0.0.254",
+						LeaseID: "lease-1",
+					},
+				},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/vm/pve-1/101":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMConfig(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_vm.test", "id", "vm-1"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "created_at", "2024-01-01T00:00:00Z"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "leases.us-east-1.address", "10.0.0.5"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "leases.us-east-1.lease_id", "lease-1"),
+				),
+			},
+			{
+				ResourceName:      "zeus_vm.test",
+				ImportState:       true,
+				ImportStateId:     "pve-1/101",
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:  "zeus_vm.test",
+				ImportState:   true,
+				ImportStateId: "pve-1",
+				ExpectError:   regexp.MustCompile(`expected import ID in the form host/vmid`),
+			},
+		},
+	})
+}
+
+func TestAccVMResource_Import(t *testing.T) {
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/vm/pve-3/301":
+			_ = json.NewEncoder(w).Encode(zeusapi.VMInfo{
+				ID:        "vm-3",
+				CreatedAt: "2024-01-01T00:00:00Z",
+				Host:      "pve-3",
+				Name:      "web-3",
+				VMID:      301,
+				Type:      "kvm",
+				Leases: map[string]zeusapi.AddressResult{
+					"us-west-2": {
+						Address: "10.2.0.5",
+						Gateway: "10.2.0.254",
+						LeaseID: "lease-3a",
+					},
+					"eu-central-1": {
+						Address: "10.3.0.5",
+						Gateway: "10.3.0.254",
+						LeaseID: "lease-3b",
+					},
+				},
+			})
+		case r.Method == http.MethodDelete && r.URL.Path == "/vm/pve-3/301":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccVMMultiRegionConfig(server.URL),
+				ResourceName:       "zeus_vm.test",
+				ImportState:        true,
+				ImportStateId:      "pve-3/301",
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(states))
+					}
+					attrs := states[0].Attributes
+					if attrs["region.0"] != "eu-central-1" || attrs["region.1"] != "us-west-2" {
+						return fmt.Errorf("unexpected region after import: %v", attrs)
+					}
+					return nil
+				},
+			},
+			{
+				Config: testAccVMMultiRegionConfig(server.URL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_vm.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_vm.test", "id", "vm-3"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "region.0", "us-west-2"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "region.1", "eu-central-1"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "leases.us-west-2.lease_id", "lease-3a"),
+				),
+			},
+			{
+				Config:   testAccVMMultiRegionConfig(server.URL),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccVMResource_MigrationResumes(t *testing.T) {
+	var mu sync.Mutex
+	vm := zeusapi.VMInfo{
+		ID:        "vm-1",
+		CreatedAt: "2024-01-01T00:00:00Z",
+		Host:      "pve-1",
+		Name:      "web-1",
+		VMID:      101,
+		Type:      "kvm",
+		Leases: map[string]zeusapi.AddressResult{
+			"us-east-1": {
+				Address: "10.0.0.5",
+				Gateway: "10.0.0.254",
+				LeaseID: "lease-1",
+			},
+		},
+	}
+	placeholders := 0
+	failMigrateOut := true
+	var placeholder zeusapi.VMMigrationRequest
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		currentPath := fmt.Sprintf("/vm/%s/%d", vm.Host, vm.VMID)
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/vms":
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateVMResponse{ID: vm.ID, Addresses: vm.Leases})
+		case r.Method == http.MethodGet && r.URL.Path == currentPath:
+			_ = json.NewEncoder(w).Encode(vm)
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/region/us-east-1":
+			_ = json.NewEncoder(w).Encode([]zeusapi.PoolDetail{
+				{ID: "pool-other", Region: "us-east-1", Begin: "10.1.0.1", End: "10.1.0.100"},
+				{ID: "pool-1", Region: "us-east-1", Begin: "10.0.0.1", End: "10.0.0.100"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/vms/migration":
+			placeholders++
+			if err := json.NewDecoder(r.Body).Decode(&placeholder); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(zeusapi.VMMigrationResponse{ID: "vm-2"})
+		case r.Method == http.MethodDelete && r.URL.Path == currentPath+"/migration":
+			if failMigrateOut {
+				failMigrateOut = false
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(vm)
+		case r.Method == http.MethodPatch && r.URL.Path == "/vm/vm-2":
+			var req zeusapi.MigrateInRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			vm.ID = "vm-2"
+			vm.Host = req.Host
+			vm.VMID = req.VMID
+			_ = json.NewEncoder(w).Encode(vm)
+		case r.Method == http.MethodDelete && r.URL.Path == currentPath:
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMConfig(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckNoResourceAttr("zeus_vm.test", "migration.%"),
+				),
+			},
+			{
+				Config:      testAccVMMigratedConfig(server.URL),
+				ExpectError: regexp.MustCompile(`Mark VM as migrating out failed`),
+			},
+			{
+				Config: testAccVMMigratedConfig(server.URL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_vm.test", "id", "vm-2"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "host", "pve-2"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "vmid", "201"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "leases.us-east-1.address", "10.0.0.5"),
+					resource.TestCheckNoResourceAttr("zeus_vm.test", "migration.%"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if placeholders != 1 {
+							return fmt.Errorf("expected 1 migration placeholder, got %d", placeholders)
+						}
+						if placeholder.Host != "pve-2" || placeholder.VMID != 201 || placeholder.Index != 4 ||
+							len(placeholder.Pools) != 1 || placeholder.Pools[0] != "pool-1" || placeholder.Type != "kvm" {
+							return fmt.Errorf("unexpected migration placeholder request: %+v", placeholder)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccVMResource_MigrationReverted(t *testing.T) {
+	var mu sync.Mutex
+	vm := zeusapi.VMInfo{
+		ID:        "vm-1",
+		CreatedAt: "2024-01-01T00:00:00Z",
+		Host:      "pve-1",
+		Name:      "web-1",
+		VMID:      101,
+		Type:      "kvm",
+		Leases: map[string]zeusapi.AddressResult{
+			"us-east-1": {
+				Address: "10.0.0.5",
+				Gateway: "10.0.0.254",
+				LeaseID: "lease-1",
+			},
+		},
+	}
+	placeholderDeleted := false
+
+	server := newTestServer(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "missing auth", http.StatusUnauthorized)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/vms":
+			_ = json.NewEncoder(w).Encode(zeusapi.CreateVMResponse{ID: vm.ID, Addresses: vm.Leases})
+		case r.Method == http.MethodGet && r.URL.Path == "/vm/pve-1/101":
+			_ = json.NewEncoder(w).Encode(vm)
+		case r.Method == http.MethodGet && r.URL.Path == "/pool/region/us-east-1":
+			_ = json.NewEncoder(w).Encode([]zeusapi.PoolDetail{
+				{ID: "pool-1", Region: "us-east-1", Begin: "10.0.0.1", End: "10.0.0.100"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/vms/migration":
+			_ = json.NewEncoder(w).Encode(zeusapi.VMMigrationResponse{ID: "vm-2"})
+		case r.Method == http.MethodDelete && r.URL.Path == "/vm/pve-1/101/migration":
+			w.WriteHeader(http.StatusInternalServerError)
+		case r.Method == http.MethodDelete && r.URL.Path == "/vm/pve-2/201":
+			placeholderDeleted = true
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/vm/pve-1/101":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { TestAccPreCheck(t) },
+		ProtoV6ProviderFactories: TestAccProviderFactories(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMConfig(server.URL),
+			},
+			{
+				Config:      testAccVMMigratedConfig(server.URL),
+				ExpectError: regexp.MustCompile(`Mark VM as migrating out failed`),
+			},
+			{
+				Config: testAccVMConfig(server.URL),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("zeus_vm.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("zeus_vm.test", "id", "vm-1"),
+					resource.TestCheckResourceAttr("zeus_vm.test", "host", "pve-1"),
+					resource.TestCheckNoResourceAttr("zeus_vm.test", "migration.%"),
+					func(*terraform.State) error {
+						mu.Lock()
+						defer mu.Unlock()
+						if !placeholderDeleted {
+							return fmt.Errorf("expected the migration placeholder on pve-2/201 to be deleted")
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config:   testAccVMConfig(server.URL),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccVMConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_vm" "test" {
+  region = ["us-east-1"]
+  host   = "pve-1"
+  name   = "web-1"
+  vmid   = 101
+  type   = "kvm"
+}
+`
+}
+
+func testAccVMMigratedConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_vm" "test" {
+  region = ["us-east-1"]
+  host   = "pve-2"
+  name   = "web-1"
+  vmid   = 201
+  type   = "kvm"
+}
+`
+}
+
+func testAccVMMultiRegionConfig(endpoint string) string {
+	return `
+provider "zeus" {
+  endpoint = "` + endpoint + `"
+  token    = "token"
+}
+
+resource "zeus_vm" "test" {
+  region = ["us-west-2", "eu-central-1"]
+  host   = "pve-3"
+  name   = "web-3"
+  vmid   = 301
+  type   = "kvm"
+}
+`
+}
@@ -0,0 +1,96 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/5aaee9/terraform-provider-zeus/internal/zeusapi"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &VMsDataSource{}
+
+func NewVMsDataSource() datasource.DataSource {
+	return &VMsDataSource{}
+}
+
+type VMsDataSource struct {
+	client *zeusapi.Client
+}
+
+type vmsDataSourceModel struct {
+	Host types.String        `tfsdk:"host"`
+	VMs  []vmDataSourceModel `tfsdk:"vms"`
+}
+
+func (d *VMsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vms"
+}
+
+func (d *VMsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all Zeus VMs on a host",
+		Attributes: map[string]schema.Attribute{
+			"host": schema.StringAttribute{
+				MarkdownDescription: "Hypervisor host to list VMs for",
+				Required:            true,
+			},
+			"vms": schema.ListNestedAttribute{
+				MarkdownDescription: "VMs registered on the host",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id":         schema.StringAttribute{Computed: true},
+						"host":       schema.StringAttribute{Computed: true},
+						"vmid":       schema.Int64Attribute{Computed: true},
+						"name":       schema.StringAttribute{Computed: true},
+						"type":       schema.StringAttribute{Computed: true},
+						"created_at": schema.StringAttribute{Computed: true},
+						"leases": schema.MapAttribute{
+							Computed:    true,
+							ElementType: leaseAttrType(),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *VMsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*zeusapi.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected provider data type", "Expected *zeusapi.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *VMsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data vmsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vms, err := d.client.ListVMs(ctx, data.Host.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Read VMs failed", err.Error())
+		return
+	}
+
+	data.VMs = make([]vmDataSourceModel, 0, len(vms))
+	for _, vm := range vms {
+		data.VMs = append(data.VMs, encodeVMInfo(vm))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -7,36 +7,148 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
+	"net"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type Client struct {
+	// unconfigured is returned by every request of a client created with
+	// NewUnconfiguredClient.
+	unconfigured error
+
 	baseURL    string
-	token      string
+	tokens     *tokenCache
 	httpClient *http.Client
+
+	maxRetries   int
+	retryMinWait time.Duration
+	retryMaxWait time.Duration
+
+	limiter  *rateLimiter
+	inFlight semaphore
+}
+
+// Option customizes a Client created by NewClient.
+type Option func(*Client)
+
+// WithMaxRetries sets how many times a failed request is retried. Zero
+// disables retries.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
 }
 
+// WithRetryMaxWait caps the delay between two attempts, including delays
+// requested by Retry-After.
+func WithRetryMaxWait(d time.Duration) Option {
+	return func(c *Client) {
+		c.retryMaxWait = d
+	}
+}
+
+// WithTokenSource makes the client fetch its token from source instead of
+// using the static token passed to NewClient. The token is fetched on first
+// use, and fetched again once when a request is rejected with 401.
+func WithTokenSource(source TokenSource) Option {
+	return func(c *Client) {
+		c.tokens = &tokenCache{source: source}
+	}
+}
+
+// WithRateLimit limits the client to rps requests per second. Zero disables
+// the limit.
+func WithRateLimit(rps float64) Option {
+	return func(c *Client) {
+		c.limiter = nil
+		if rps > 0 {
+			c.limiter = newRateLimiter(rps)
+		}
+	}
+}
+
+// WithMaxConcurrentRequests limits how many requests the client has in flight
+// at once. Zero disables the limit.
+func WithMaxConcurrentRequests(n int) Option {
+	return func(c *Client) {
+		c.inFlight = nil
+		if n > 0 {
+			c.inFlight = make(semaphore, n)
+		}
+	}
+}
+
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+
+	defaultRetryMinWait = 500 * time.Millisecond
+)
+
+// maxErrorBodyBytes caps how much of an error response is kept in APIError.
+const maxErrorBodyBytes = 512
+
+// APIError is a response from Zeus with a status of 400 or above.
 type APIError struct {
 	StatusCode int
-	Message    string
+	// Message is the "error" field of the response or, for errors raised
+	// by Echo itself such as a rejected token, the "message" field.
+	Message string
+
+	Method string
+	Path   string
+	// RequestID is the X-Request-Id header of the response. Quote it when
+	// reporting a failure to the Zeus team.
+	RequestID string
+	// Body is the start of the raw response body.
+	Body string
 }
 
 func (e *APIError) Error() string {
-	if e.Message != "" {
-		return fmt.Sprintf("status %d: %s", e.StatusCode, e.Message)
+	var b strings.Builder
+	if e.Method != "" {
+		fmt.Fprintf(&b, "%s %s: ", e.Method, e.Path)
+	}
+	fmt.Fprintf(&b, "status %d", e.StatusCode)
+	switch {
+	case e.Message != "":
+		b.WriteString(": " + e.Message)
+	case e.Body != "":
+		b.WriteString(": " + e.Body)
+	}
+	if e.RequestID != "" {
+		fmt.Fprintf(&b, " (request ID %s)", e.RequestID)
 	}
-	return fmt.Sprintf("status %d", e.StatusCode)
+	return b.String()
 }
 
 func (e *APIError) NotFound() bool {
 	return e.StatusCode == http.StatusNotFound
 }
 
-func NewClient(baseURL, token string, httpClient *http.Client) (*Client, error) {
+func (e *APIError) Conflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+func (e *APIError) Unauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+func (e *APIError) BadRequest() bool {
+	return e.StatusCode == http.StatusBadRequest
+}
+
+func NewClient(baseURL, token string, httpClient *http.Client, opts ...Option) (*Client, error) {
 	if httpClient == nil {
 		httpClient = http.DefaultClient
 	}
@@ -45,12 +157,36 @@ func NewClient(baseURL, token string, httpClient *http.Client) (*Client, error)
 	if err != nil {
 		return nil, fmt.Errorf("parse base url: %w", err)
 	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return nil, fmt.Errorf("base url %q must include a scheme and host, e.g. http://zeus:8080", baseURL)
+	}
+
+	c := &Client{
+		baseURL:      strings.TrimRight(parsed.String(), "/"),
+		tokens:       &tokenCache{source: StaticTokenSource(token)},
+		httpClient:   httpClient,
+		maxRetries:   DefaultMaxRetries,
+		retryMinWait: defaultRetryMinWait,
+		retryMaxWait: DefaultRetryMaxWait,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.maxRetries < 0 {
+		return nil, fmt.Errorf("max retries must not be negative")
+	}
+	if c.retryMaxWait < 0 {
+		return nil, fmt.Errorf("retry max wait must not be negative")
+	}
+
+	return c, nil
+}
 
-	return &Client{
-		baseURL:    strings.TrimRight(parsed.String(), "/"),
-		token:      token,
-		httpClient: httpClient,
-	}, nil
+// NewUnconfiguredClient returns a client whose requests all fail with err. It
+// stands in for a real client while the settings needed to build one are not
+// known yet.
+func NewUnconfiguredClient(err error) *Client {
+	return &Client{unconfigured: err}
 }
 
 func (c *Client) do(ctx context.Context, method, path string, payload any, out any) error {
@@ -58,44 +194,150 @@ func (c *Client) do(ctx context.Context, method, path string, payload any, out a
 }
 
 func (c *Client) doWithHeaders(ctx context.Context, method, path string, payload any, headers map[string]string, out any) error {
+	if c.unconfigured != nil {
+		return c.unconfigured
+	}
+
 	fullURL := c.baseURL + path
 
-	var body io.Reader
+	var payloadBytes []byte
 	if payload != nil {
 		buf := &bytes.Buffer{}
 		if err := json.NewEncoder(buf).Encode(payload); err != nil {
 			return fmt.Errorf("encode payload: %w", err)
 		}
-		body = buf
+		payloadBytes = buf.Bytes()
 	}
 
-	req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
-	if err != nil {
-		return fmt.Errorf("build request: %w", err)
+	ctx = newLogContext(ctx)
+
+	tokenRefreshed := false
+	for attempt := 0; ; {
+		var token string
+		if requiresToken(path) {
+			fetched, err := c.tokens.get(ctx)
+			if err != nil {
+				return &TokenError{Err: err}
+			}
+			token = fetched
+		}
+		logCtx := maskToken(ctx, token)
+
+		// The body is rebuilt for every attempt since a previous one may
+		// have consumed it.
+		var body io.Reader
+		if payloadBytes != nil {
+			body = bytes.NewReader(payloadBytes)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, method, fullURL, body)
+		if err != nil {
+			return fmt.Errorf("build request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		if err := c.acquire(ctx); err != nil {
+			return err
+		}
+
+		logRequest(logCtx, req, payloadBytes, attempt)
+		start := time.Now()
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			c.release()
+			tflog.SubsystemDebug(logCtx, LogSubsystem, "Zeus API request failed", map[string]any{
+				"http_method": method,
+				"http_url":    fullURL,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"error":       err.Error(),
+			})
+			if attempt < c.maxRetries && retryableTransportError(ctx, method, err) {
+				delay := c.backoff(attempt)
+				logRetry(logCtx, method, fullURL, delay)
+				if waitErr := c.wait(ctx, delay); waitErr != nil {
+					return fmt.Errorf("send request: %w", err)
+				}
+				attempt++
+				continue
+			}
+			return fmt.Errorf("send request: %w", err)
+		}
+		logResponse(logCtx, req, resp, time.Since(start))
+
+		// A rejected token may have expired. The request was refused before
+		// being processed, so it is safe to resend with a fresh token.
+		if resp.StatusCode == http.StatusUnauthorized && token != "" && !tokenRefreshed && c.tokens.refreshable() {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			c.release()
+
+			tflog.SubsystemDebug(logCtx, LogSubsystem, "Zeus rejected the token, fetching a new one")
+			c.tokens.invalidate(token)
+			tokenRefreshed = true
+			continue
+		}
+
+		if attempt < c.maxRetries && retryableStatus(method, resp.StatusCode) {
+			delay := c.backoff(attempt)
+			if after, ok := retryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				delay = min(after, c.retryMaxWait)
+			}
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			c.release()
+
+			logRetry(logCtx, method, fullURL, delay)
+			if err := c.wait(ctx, delay); err != nil {
+				return err
+			}
+			attempt++
+			continue
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		c.release()
+		if err != nil {
+			return fmt.Errorf("read response: %w", err)
+		}
+		logResponseBody(logCtx, req, respBody)
+
+		return decodeResponse(method, path, resp, respBody, out)
 	}
-	req.Header.Set("Content-Type", "application/json")
-	if c.token != "" {
-		req.Header.Set("Authorization", "Bearer "+c.token)
+}
+
+// acquire takes an in-flight slot and a rate limit token for one attempt. The
+// slot is given back with release once the response has been read.
+func (c *Client) acquire(ctx context.Context) error {
+	if c.inFlight != nil {
+		if err := c.inFlight.acquire(ctx); err != nil {
+			return err
+		}
 	}
-	for key, value := range headers {
-		req.Header.Set(key, value)
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			c.release()
+			return err
+		}
 	}
+	return nil
+}
 
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return fmt.Errorf("send request: %w", err)
+func (c *Client) release() {
+	if c.inFlight != nil {
+		c.inFlight.release()
 	}
-	defer resp.Body.Close()
+}
 
+func decodeResponse(method, path string, resp *http.Response, body []byte, out any) error {
 	if resp.StatusCode >= 400 {
-		var eResp struct {
-			Error string `json:"error"`
-		}
-		_ = json.NewDecoder(resp.Body).Decode(&eResp)
-		return &APIError{
-			StatusCode: resp.StatusCode,
-			Message:    eResp.Error,
-		}
+		return newAPIError(method, path, resp, body)
 	}
 
 	if out == nil {
@@ -106,12 +348,150 @@ func (c *Client) doWithHeaders(ctx context.Context, method, path string, payload
 		return nil
 	}
 
-	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(out); err != nil {
 		return fmt.Errorf("decode response: %w", err)
 	}
 	return nil
 }
 
+// newAPIError decodes both error shapes Zeus answers with: {"error": ...}
+// from its handlers and {"message": ...} from Echo itself.
+func newAPIError(method, path string, resp *http.Response, body []byte) *APIError {
+	var eResp struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	}
+	_ = json.Unmarshal(body, &eResp)
+
+	message := eResp.Error
+	if message == "" {
+		message = eResp.Message
+	}
+
+	snippet := strings.TrimSpace(string(body))
+	if len(snippet) > maxErrorBodyBytes {
+		snippet = strings.ToValidUTF8(snippet[:maxErrorBodyBytes], "") + "..."
+	}
+
+	return &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    message,
+		Method:     method,
+		Path:       path,
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       snippet,
+	}
+}
+
+// idempotentMethod reports whether repeating a request with the given method
+// cannot change the outcome on the server.
+func idempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryableStatus(method string, status int) bool {
+	if !idempotentMethod(method) {
+		return false
+	}
+	switch status {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryableTransportError reports whether a request that failed without a
+// response may be sent again. Non-idempotent requests are only retried when
+// the connection could not be established, since the server cannot have seen
+// them.
+func retryableTransportError(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if idempotentMethod(method) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// retryAfter parses a Retry-After header given either in seconds or as an
+// HTTP date.
+func retryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// backoff returns the delay before retry number attempt+1: exponential growth
+// from retryMinWait capped at retryMaxWait, with the upper half jittered.
+func (c *Client) backoff(attempt int) time.Duration {
+	delay := c.retryMaxWait
+	if attempt < 32 {
+		delay = min(c.retryMinWait<<attempt, c.retryMaxWait)
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+func (c *Client) wait(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type HealthResponse struct {
+	Message string `json:"message"`
+}
+
+type ConnectionCheckResponse struct {
+	OK bool `json:"ok"`
+}
+
+// Health reports whether the Zeus backend is up. It does not need a token.
+func (c *Client) Health(ctx context.Context) (HealthResponse, error) {
+	var resp HealthResponse
+	err := c.do(ctx, http.MethodGet, healthPath, nil, &resp)
+	return resp, err
+}
+
+// CheckConnection verifies that the configured token is accepted.
+func (c *Client) CheckConnection(ctx context.Context) (ConnectionCheckResponse, error) {
+	var resp ConnectionCheckResponse
+	err := c.do(ctx, http.MethodGet, "/connection/check", nil, &resp)
+	return resp, err
+}
+
 type CreatePoolRequest struct {
 	Start   int64  `json:"start"`
 	Gateway int64  `json:"gateway"`
@@ -133,6 +513,18 @@ type PoolDetail struct {
 	State        []int64 `json:"state"`
 }
 
+type PoolUsage struct {
+	Size         int64  `json:"size"`
+	Used         int64  `json:"used"`
+	FriendlyName string `json:"friendlyName"`
+}
+
+func (c *Client) GetPoolInfo(ctx context.Context) (map[string]PoolUsage, error) {
+	var resp map[string]PoolUsage
+	err := c.do(ctx, http.MethodGet, "/pool/info", nil, &resp)
+	return resp, err
+}
+
 func (c *Client) CreatePool(ctx context.Context, req CreatePoolRequest) (CreatePoolResponse, error) {
 	var resp CreatePoolResponse
 	err := c.do(ctx, http.MethodPost, "/pools", req, &resp)
@@ -145,10 +537,83 @@ func (c *Client) GetPoolByID(ctx context.Context, id string) (PoolDetail, error)
 	return resp, err
 }
 
+func (c *Client) ListPoolsByRegion(ctx context.Context, region string) ([]PoolDetail, error) {
+	var resp []PoolDetail
+	err := c.do(ctx, http.MethodGet, "/pool/region/"+region, nil, &resp)
+	return resp, err
+}
+
+func (c *Client) ReconcilePool(ctx context.Context, id string) (PoolDetail, error) {
+	var resp PoolDetail
+	err := c.do(ctx, http.MethodPost, "/pool/"+id+"/reconcile", nil, &resp)
+	return resp, err
+}
+
 func (c *Client) DeletePool(ctx context.Context, id string) error {
 	return c.do(ctx, http.MethodDelete, "/pool/"+id, nil, nil)
 }
 
+type Region struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	FriendlyName string `json:"friendlyName"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type CreateRegionRequest struct {
+	Name         string `json:"name"`
+	FriendlyName string `json:"friendlyName"`
+}
+
+type CreateRegionResponse struct {
+	ID string `json:"id"`
+}
+
+type UpdateRegionRequest struct {
+	Name         *string `json:"name,omitempty"`
+	FriendlyName *string `json:"friendlyName,omitempty"`
+}
+
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	var resp []Region
+	err := c.do(ctx, http.MethodGet, "/regions", nil, &resp)
+	return resp, err
+}
+
+// GetRegion looks a region up by ID. Zeus has no single-region endpoint, so
+// this lists all regions and reports a 404 APIError when the ID is missing.
+func (c *Client) GetRegion(ctx context.Context, id string) (Region, error) {
+	regions, err := c.ListRegions(ctx)
+	if err != nil {
+		return Region{}, err
+	}
+	for _, region := range regions {
+		if region.ID == id {
+			return region, nil
+		}
+	}
+	return Region{}, &APIError{
+		StatusCode: http.StatusNotFound,
+		Message:    "region not found",
+	}
+}
+
+func (c *Client) CreateRegion(ctx context.Context, req CreateRegionRequest) (CreateRegionResponse, error) {
+	var resp CreateRegionResponse
+	err := c.do(ctx, http.MethodPost, "/regions", req, &resp)
+	return resp, err
+}
+
+func (c *Client) UpdateRegion(ctx context.Context, id string, req UpdateRegionRequest) (Region, error) {
+	var resp Region
+	err := c.do(ctx, http.MethodPatch, "/region/"+id, req, &resp)
+	return resp, err
+}
+
+func (c *Client) DeleteRegion(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "/region/"+id, nil, nil)
+}
+
 type AddressResult struct {
 	Address string `json:"address"`
 	Gateway string `json:"gateway"`
@@ -169,6 +634,24 @@ type AssignCreateResponse struct {
 	Addresses map[string]AddressResult `json:"addresses"`
 }
 
+type AssignCreateIndexRequest struct {
+	Pools []string `json:"pools"`
+	Index int64    `json:"index"`
+	Host  string   `json:"host"`
+	Key   string   `json:"key"`
+	Type  string   `json:"type"`
+	Data  any      `json:"data,omitempty"`
+}
+
+type AssignCreateIndexResponse struct {
+	ID string `json:"id"`
+}
+
+type AssignRegionRequest struct {
+	Region string `json:"region"`
+	Host   string `json:"host,omitempty"`
+}
+
 type AssignInfo struct {
 	ID        string                   `json:"id"`
 	CreatedAt string                   `json:"createdAt"`
@@ -184,16 +667,160 @@ func (c *Client) CreateAssign(ctx context.Context, req AssignCreateRequest) (Ass
 	return resp, err
 }
 
+func (c *Client) CreateAssignByIndex(ctx context.Context, req AssignCreateIndexRequest) (AssignCreateIndexResponse, error) {
+	var resp AssignCreateIndexResponse
+	err := c.do(ctx, http.MethodPost, "/assigns/index", req, &resp)
+	return resp, err
+}
+
 func (c *Client) GetAssign(ctx context.Context, id string) (AssignInfo, error) {
 	var resp AssignInfo
 	err := c.do(ctx, http.MethodGet, "/assign/"+id, nil, &resp)
 	return resp, err
 }
 
+func (c *Client) GetAssignByIndex(ctx context.Context, pool string, index int64) (AssignInfo, error) {
+	query := url.Values{}
+	query.Set("pool", pool)
+	query.Set("index", strconv.FormatInt(index, 10))
+
+	var resp AssignInfo
+	err := c.do(ctx, http.MethodGet, "/assigns/index?"+query.Encode(), nil, &resp)
+	return resp, err
+}
+
+func (c *Client) AddAssignRegion(ctx context.Context, id string, req AssignRegionRequest) (AssignInfo, error) {
+	var resp AssignInfo
+	err := c.do(ctx, http.MethodPost, "/assign/"+id+"/region", req, &resp)
+	return resp, err
+}
+
+func (c *Client) RemoveAssignRegion(ctx context.Context, id, region string) (AssignInfo, error) {
+	var resp AssignInfo
+	err := c.do(ctx, http.MethodDelete, "/assign/"+id+"/region/"+region, nil, &resp)
+	return resp, err
+}
+
 func (c *Client) DeleteAssign(ctx context.Context, id string) error {
 	return c.do(ctx, http.MethodDelete, "/assign/"+id, nil, nil)
 }
 
+type CreateVMRequest struct {
+	Region []string `json:"region"`
+	Host   string   `json:"host"`
+	Name   string   `json:"name"`
+	VMID   int64    `json:"vmid"`
+	Type   string   `json:"type"`
+}
+
+type CreateVMResponse struct {
+	ID        string                   `json:"id"`
+	Addresses map[string]AddressResult `json:"addresses"`
+}
+
+type VMInfo struct {
+	ID        string                   `json:"id"`
+	CreatedAt string                   `json:"createdAt"`
+	Host      string                   `json:"host"`
+	Leases    map[string]AddressResult `json:"leases"`
+	Name      string                   `json:"name"`
+	VMID      int64                    `json:"vmid"`
+	Type      string                   `json:"type"`
+}
+
+func (c *Client) CreateVM(ctx context.Context, req CreateVMRequest) (CreateVMResponse, error) {
+	var resp CreateVMResponse
+	err := c.do(ctx, http.MethodPost, "/vms", req, &resp)
+	return resp, err
+}
+
+func (c *Client) GetVM(ctx context.Context, host string, vmid int64) (VMInfo, error) {
+	var resp VMInfo
+	err := c.do(ctx, http.MethodGet, vmPath(host, vmid), nil, &resp)
+	return resp, err
+}
+
+func (c *Client) ListVMs(ctx context.Context, host string) ([]VMInfo, error) {
+	var resp []VMInfo
+	err := c.do(ctx, http.MethodGet, "/vm/"+host, nil, &resp)
+	return resp, err
+}
+
+func (c *Client) DeleteVM(ctx context.Context, host string, vmid int64) error {
+	return c.do(ctx, http.MethodDelete, vmPath(host, vmid), nil, nil)
+}
+
+type VMMigrationRequest struct {
+	Host  string   `json:"host"`
+	VMID  int64    `json:"vmid"`
+	Pools []string `json:"pools"`
+	Index int64    `json:"index"`
+	Type  string   `json:"type"`
+}
+
+type VMMigrationResponse struct {
+	ID string `json:"id"`
+}
+
+type MigrateInRequest struct {
+	Host string `json:"host"`
+	VMID int64  `json:"vmid"`
+}
+
+func (c *Client) CreateVMMigration(ctx context.Context, req VMMigrationRequest) (VMMigrationResponse, error) {
+	var resp VMMigrationResponse
+	err := c.do(ctx, http.MethodPost, "/vms/migration", req, &resp)
+	return resp, err
+}
+
+func (c *Client) MigrateOutVM(ctx context.Context, host string, vmid int64) (VMInfo, error) {
+	var resp VMInfo
+	err := c.do(ctx, http.MethodDelete, vmPath(host, vmid)+"/migration", nil, &resp)
+	return resp, err
+}
+
+func (c *Client) MigrateInVM(ctx context.Context, id string, req MigrateInRequest) (VMInfo, error) {
+	var resp VMInfo
+	err := c.do(ctx, http.MethodPatch, "/vm/"+id, req, &resp)
+	return resp, err
+}
+
+func vmPath(host string, vmid int64) string {
+	return "/vm/" + host + "/" + strconv.FormatInt(vmid, 10)
+}
+
+// SystemImage is passed through from the upstream image catalog, so its shape
+// is not fixed. Known fields are id, createdAt, flavor, version and imageUrl.
+// Numbers are kept as json.Number so large numeric IDs are not rounded.
+type SystemImage map[string]any
+
+func (s *SystemImage) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var fields map[string]any
+	if err := dec.Decode(&fields); err != nil {
+		return err
+	}
+	*s = fields
+	return nil
+}
+
+func (c *Client) GetLatestImage(ctx context.Context, flavor string) (SystemImage, error) {
+	query := url.Values{}
+	query.Set("flavor", flavor)
+
+	var resp SystemImage
+	err := c.do(ctx, http.MethodGet, "/images/latest?"+query.Encode(), nil, &resp)
+	return resp, err
+}
+
+func (c *Client) GetImage(ctx context.Context, flavor, version string) (SystemImage, error) {
+	var resp SystemImage
+	err := c.do(ctx, http.MethodGet, "/image/"+flavor+"/"+version, nil, &resp)
+	return resp, err
+}
+
 type CreatePortRequest struct {
 	AssignID   string `json:"assignId"`
 	TargetPort int64  `json:"targetPort"`
@@ -236,3 +863,24 @@ func (c *Client) GetPortByID(ctx context.Context, id string) (PortInfo, error) {
 func (c *Client) DeletePortByID(ctx context.Context, id string) error {
 	return c.do(ctx, http.MethodDelete, "/port/id/"+id, nil, nil)
 }
+
+// ListAssignPorts returns the ports allocated to an assign within a port scope
+// host. Entries carry id, port, targetPort and service.
+func (c *Client) ListAssignPorts(ctx context.Context, scopeHost, assignID string) ([]PortInfo, error) {
+	var resp []PortInfo
+	err := c.do(ctx, http.MethodGet, "/ports/"+scopeHost+"/"+assignID, nil, &resp)
+	return resp, err
+}
+
+type ForwardRule struct {
+	InPort  int64  `json:"inPort"`
+	OutPort int64  `json:"outPort"`
+	OutHost string `json:"outHost"`
+	Proto   string `json:"proto"`
+}
+
+func (c *Client) ListForwardRules(ctx context.Context, scopeHost string) ([]ForwardRule, error) {
+	var resp []ForwardRule
+	err := c.do(ctx, http.MethodGet, "/ports/"+scopeHost, nil, &resp)
+	return resp, err
+}
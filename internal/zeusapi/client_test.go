@@ -0,0 +1,388 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(t *testing.T, baseURL string, httpClient *http.Client, opts ...Option) *Client {
+	t.Helper()
+
+	c, err := NewClient(baseURL, "token", httpClient, opts...)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	c.retryMinWait = time.Millisecond
+	return c
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClientRetriesIdempotentOnRetryableStatus(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			http.Error(w, "bad gateway", http.StatusBadGateway)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]Region{{ID: "region-1"}})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	regions, err := c.ListRegions(context.Background())
+	if err != nil {
+		t.Fatalf("ListRegions: %v", err)
+	}
+	if len(regions) != 1 || regions[0].ID != "region-1" {
+		t.Fatalf("unexpected regions: %v", regions)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestClientStopsAfterMaxRetries(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_ = json.NewEncoder(w).Encode(map[string]string{"error": "maintenance"})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil, WithMaxRetries(2))
+	_, err := c.GetPoolByID(context.Background(), "pool-1")
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable || apiErr.Message != "maintenance" {
+		t.Fatalf("expected 503 APIError, got %v", err)
+	}
+	if got := attempts.Load(); got != 3 {
+		t.Fatalf("expected 3 attempts, got %d", got)
+	}
+}
+
+func TestClientDoesNotRetryPostOnStatus(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	_, err := c.CreateRegion(context.Background(), CreateRegionRequest{Name: "us-east-1"})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusBadGateway {
+		t.Fatalf("expected 502 APIError, got %v", err)
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestClientRetriesPostWhenDialFailsAndResendsBody(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		_ = json.NewEncoder(w).Encode(CreateRegionResponse{ID: "region-1"})
+	}))
+	defer server.Close()
+
+	var attempts atomic.Int32
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if attempts.Add(1) == 1 {
+			// Consume the body like a transport that failed mid-way would.
+			_, _ = io.ReadAll(req.Body)
+			return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	})
+
+	c := newTestClient(t, server.URL, &http.Client{Transport: transport})
+	resp, err := c.CreateRegion(context.Background(), CreateRegionRequest{Name: "us-east-1", FriendlyName: "US East"})
+	if err != nil {
+		t.Fatalf("CreateRegion: %v", err)
+	}
+	if resp.ID != "region-1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+	if len(bodies) != 1 || bodies[0] != "{\"name\":\"us-east-1\",\"friendlyName\":\"US East\"}\n" {
+		t.Fatalf("unexpected request bodies: %q", bodies)
+	}
+}
+
+func TestClientDoesNotRetryPostAfterConnectionReset(t *testing.T) {
+	var attempts atomic.Int32
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	})
+
+	c := newTestClient(t, "http://zeus.invalid", &http.Client{Transport: transport})
+	if _, err := c.CreateRegion(context.Background(), CreateRegionRequest{Name: "us-east-1"}); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+func TestClientRetriesGetAfterConnectionReset(t *testing.T) {
+	var attempts atomic.Int32
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		attempts.Add(1)
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	})
+
+	c := newTestClient(t, "http://zeus.invalid", &http.Client{Transport: transport}, WithMaxRetries(1))
+	if _, err := c.ListRegions(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestClientHonorsRetryAfter(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_ = json.NewEncoder(w).Encode([]Region{})
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	// Without Retry-After the backoff would take an hour.
+	c.retryMinWait = time.Hour
+	c.retryMaxWait = time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := c.ListRegions(ctx); err != nil {
+		t.Fatalf("ListRegions: %v", err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+}
+
+func TestClientRetryWaitRespectsContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil, WithRetryMaxWait(time.Hour))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.ListRegions(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		"empty":    {value: "", ok: false},
+		"seconds":  {value: "7", want: 7 * time.Second, ok: true},
+		"negative": {value: "-1", ok: false},
+		"date":     {value: now.Add(90 * time.Second).Format(http.TimeFormat), want: 90 * time.Second, ok: true},
+		"past":     {value: now.Add(-time.Minute).Format(http.TimeFormat), want: 0, ok: true},
+		"garbage":  {value: "soon", ok: false},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, ok := retryAfter(tc.value, now)
+			if got != tc.want || ok != tc.ok {
+				t.Fatalf("retryAfter(%q) = %v, %v; want %v, %v", tc.value, got, ok, tc.want, tc.ok)
+			}
+		})
+	}
+}
+
+func TestBackoffBounds(t *testing.T) {
+	c := &Client{retryMinWait: 100 * time.Millisecond, retryMaxWait: time.Second}
+
+	for attempt, want := range []time.Duration{
+		100 * time.Millisecond,
+		200 * time.Millisecond,
+		400 * time.Millisecond,
+		800 * time.Millisecond,
+		time.Second,
+		time.Second,
+	} {
+		for range 50 {
+			got := c.backoff(attempt)
+			if got < want/2 || got > want {
+				t.Fatalf("backoff(%d) = %v, want within [%v, %v]", attempt, got, want/2, want)
+			}
+		}
+	}
+
+	if got := c.backoff(100); got < c.retryMaxWait/2 || got > c.retryMaxWait {
+		t.Fatalf("backoff(100) = %v, want capped at %v", got, c.retryMaxWait)
+	}
+}
+
+func TestNewClientRejectsNegativeRetrySettings(t *testing.T) {
+	if _, err := NewClient("http://zeus", "token", nil, WithMaxRetries(-1)); err == nil {
+		t.Fatal("expected error for negative max retries")
+	}
+	if _, err := NewClient("http://zeus", "token", nil, WithRetryMaxWait(-time.Second)); err == nil {
+		t.Fatal("expected error for negative retry max wait")
+	}
+}
+
+func TestNewClientRejectsIncompleteBaseURL(t *testing.T) {
+	for _, baseURL := range []string{"", "zeus:8080", "/api", "http://"} {
+		if _, err := NewClient(baseURL, "token", nil); err == nil {
+			t.Errorf("expected error for base url %q", baseURL)
+		}
+	}
+}
+
+func TestUnconfiguredClient(t *testing.T) {
+	reason := errors.New("endpoint not known yet")
+	c := NewUnconfiguredClient(reason)
+	if _, err := c.ListRegions(context.Background()); !errors.Is(err, reason) {
+		t.Fatalf("expected %v, got %v", reason, err)
+	}
+}
+
+func TestAPIErrorShapes(t *testing.T) {
+	testCases := map[string]struct {
+		status      int
+		contentType string
+		body        string
+		wantMessage string
+		wantError   string
+	}{
+		"handler-error": {
+			status:      http.StatusConflict,
+			body:        `{"error":"region name already exists"}`,
+			wantMessage: "region name already exists",
+			wantError:   "POST /regions: status 409: region name already exists (request ID req-42)",
+		},
+		"echo-message": {
+			status:      http.StatusUnauthorized,
+			body:        `{"message":"Unauthorized"}`,
+			wantMessage: "Unauthorized",
+			wantError:   "POST /regions: status 401: Unauthorized (request ID req-42)",
+		},
+		"proxy-page": {
+			status:      http.StatusBadRequest,
+			contentType: "text/html",
+			body:        "<html>bad request</html>\n",
+			wantError:   "POST /regions: status 400: <html>bad request</html> (request ID req-42)",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tc.contentType != "" {
+					w.Header().Set("Content-Type", tc.contentType)
+				}
+				w.Header().Set("X-Request-Id", "req-42")
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer server.Close()
+
+			c := newTestClient(t, server.URL, nil)
+			_, err := c.CreateRegion(context.Background(), CreateRegionRequest{Name: "us-east-1"})
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+			if apiErr.Message != tc.wantMessage || apiErr.RequestID != "req-42" || apiErr.Method != http.MethodPost || apiErr.Path != "/regions" {
+				t.Fatalf("unexpected error fields: %+v", apiErr)
+			}
+			if apiErr.Body != strings.TrimSpace(tc.body) {
+				t.Fatalf("unexpected body snippet %q", apiErr.Body)
+			}
+			if err.Error() != tc.wantError {
+				t.Fatalf("Error() = %q, want %q", err.Error(), tc.wantError)
+			}
+		})
+	}
+}
+
+func TestGetImageKeepsLargeNumbers(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `{"id":9007199254740993,"flavor":"debian","version":12}`)
+	}))
+	defer srv.Close()
+
+	c := newTestClient(t, srv.URL, srv.Client())
+	image, err := c.GetImage(context.Background(), "debian", "12")
+	if err != nil {
+		t.Fatalf("GetImage: %v", err)
+	}
+	if image["id"] != json.Number("9007199254740993") {
+		t.Fatalf("id = %#v, want json.Number 9007199254740993", image["id"])
+	}
+	if image["version"] != json.Number("12") {
+		t.Fatalf("version = %#v, want json.Number 12", image["version"])
+	}
+}
+
+func TestAPIErrorPredicates(t *testing.T) {
+	for status, is := range map[int]func(*APIError) bool{
+		http.StatusNotFound:     (*APIError).NotFound,
+		http.StatusConflict:     (*APIError).Conflict,
+		http.StatusUnauthorized: (*APIError).Unauthorized,
+		http.StatusBadRequest:   (*APIError).BadRequest,
+	} {
+		if !is(&APIError{StatusCode: status}) {
+			t.Errorf("predicate for %d does not match its status", status)
+		}
+		if is(&APIError{StatusCode: http.StatusInternalServerError}) {
+			t.Errorf("predicate for %d matches status 500", status)
+		}
+	}
+}
+
+func TestAPIErrorTruncatesBody(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusBadGateway, Header: http.Header{}}
+	apiErr := newAPIError(http.MethodGet, "/regions", resp, []byte(strings.Repeat("x", 2*maxErrorBodyBytes)))
+	if len(apiErr.Body) != maxErrorBodyBytes+len("...") {
+		t.Fatalf("expected the body to be cut to %d bytes, got %d", maxErrorBodyBytes, len(apiErr.Body))
+	}
+}
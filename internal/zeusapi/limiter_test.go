@@ -0,0 +1,142 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(2)
+	l.now = func() time.Time { return now }
+
+	// The bucket starts full with one second worth of tokens.
+	for i := range 2 {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("reserve %d: expected no delay, got %v", i, got)
+		}
+	}
+	if got := l.reserve(); got != 500*time.Millisecond {
+		t.Fatalf("expected 500ms delay, got %v", got)
+	}
+	if got := l.reserve(); got != time.Second {
+		t.Fatalf("expected 1s delay for the queued caller, got %v", got)
+	}
+
+	// Refilling never exceeds the burst size.
+	now = now.Add(time.Hour)
+	for i := range 2 {
+		if got := l.reserve(); got != 0 {
+			t.Fatalf("reserve %d after refill: expected no delay, got %v", i, got)
+		}
+	}
+	if got := l.reserve(); got == 0 {
+		t.Fatal("expected a delay once the refilled bucket is empty")
+	}
+}
+
+func TestRateLimiterWaitCancelReturnsToken(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := newRateLimiter(0.001)
+	l.now = func() time.Time { return now }
+
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("first wait: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+	if l.tokens != 0 {
+		t.Fatalf("expected the canceled reservation to be returned, tokens = %v", l.tokens)
+	}
+}
+
+func TestClientMaxConcurrentRequests(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil, WithMaxConcurrentRequests(2))
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.ListRegions(context.Background()); err != nil {
+				t.Errorf("ListRegions: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got > 2 {
+		t.Fatalf("expected at most 2 requests in flight, saw %d", got)
+	}
+}
+
+func TestClientRateLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil, WithRateLimit(20))
+
+	start := time.Now()
+	for range 30 {
+		if _, err := c.ListRegions(context.Background()); err != nil {
+			t.Fatalf("ListRegions: %v", err)
+		}
+	}
+	// 20 requests fit in the initial burst, the remaining 10 need 500ms.
+	if elapsed := time.Since(start); elapsed < 450*time.Millisecond {
+		t.Fatalf("expected rate limiting to take at least 450ms, took %v", elapsed)
+	}
+}
+
+func TestClientMaxConcurrentRequestsRespectsContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := newTestClient(t, server.URL, nil, WithMaxConcurrentRequests(1))
+
+	go func() { _, _ = c.ListRegions(context.Background()) }()
+	for len(c.inFlight) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := c.ListRegions(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded while waiting for a slot, got %v", err)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem requests to Zeus are logged to. Its
+// level can be set on its own with TF_LOG_PROVIDER_ZEUS_API.
+const LogSubsystem = "zeus_api"
+
+const (
+	// Pool state arrays hold one entry per address of the pool, so only
+	// their head is logged.
+	maxLoggedStateEntries = 64
+	maxLoggedBodyBytes    = 32 << 10
+
+	redacted = "<redacted>"
+)
+
+func newLogContext(ctx context.Context) context.Context {
+	return tflog.NewSubsystem(ctx, LogSubsystem,
+		tflog.WithLevelFromEnv("TF_LOG_PROVIDER_ZEUS_API"),
+		tflog.WithRootFields(),
+	)
+}
+
+// maskToken keeps token out of every message and field logged with the
+// returned context, including request and response bodies.
+func maskToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, token)
+	return tflog.SubsystemMaskMessageStrings(ctx, LogSubsystem, token)
+}
+
+func logRequest(ctx context.Context, req *http.Request, payload []byte, attempt int) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending Zeus API request", map[string]any{
+		"http_method":     req.Method,
+		"http_url":        req.URL.String(),
+		"http_headers":    loggableHeaders(req.Header),
+		"attempt":         attempt + 1,
+		"http_body_bytes": len(payload),
+	})
+	if payload != nil {
+		tflog.SubsystemTrace(ctx, LogSubsystem, "Zeus API request body", map[string]any{
+			"http_method": req.Method,
+			"http_url":    req.URL.String(),
+			"http_body":   loggableBody(payload),
+		})
+	}
+}
+
+func logResponse(ctx context.Context, req *http.Request, resp *http.Response, elapsed time.Duration) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received Zeus API response", map[string]any{
+		"http_method":      req.Method,
+		"http_url":         req.URL.String(),
+		"http_status_code": resp.StatusCode,
+		"http_headers":     loggableHeaders(resp.Header),
+		"duration_ms":      elapsed.Milliseconds(),
+	})
+}
+
+func logResponseBody(ctx context.Context, req *http.Request, body []byte) {
+	tflog.SubsystemTrace(ctx, LogSubsystem, "Zeus API response body", map[string]any{
+		"http_method": req.Method,
+		"http_url":    req.URL.String(),
+		"http_body":   loggableBody(body),
+	})
+}
+
+func logRetry(ctx context.Context, method, reqURL string, delay time.Duration) {
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Retrying Zeus API request", map[string]any{
+		"http_method": method,
+		"http_url":    reqURL,
+		"delay_ms":    delay.Milliseconds(),
+	})
+}
+
+func loggableHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for key, values := range h {
+		if key == "Authorization" {
+			out[key] = redacted
+			continue
+		}
+		out[key] = strings.Join(values, ", ")
+	}
+	return out
+}
+
+// loggableBody shortens large pool state arrays and caps the overall size of
+// body so a debug log of a big apply stays readable.
+func loggableBody(body []byte) string {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err == nil && truncateStateArrays(v) {
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		if err := enc.Encode(v); err == nil {
+			body = bytes.TrimSpace(buf.Bytes())
+		}
+	}
+
+	if len(body) > maxLoggedBodyBytes {
+		return fmt.Sprintf("%s... (%d more bytes)", body[:maxLoggedBodyBytes], len(body)-maxLoggedBodyBytes)
+	}
+	return string(body)
+}
+
+// truncateStateArrays cuts every "state" array nested in v down to
+// maxLoggedStateEntries and reports whether anything was cut.
+func truncateStateArrays(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if state, ok := value.([]any); ok && key == "state" && len(state) > maxLoggedStateEntries {
+				v[key] = append(state[:maxLoggedStateEntries:maxLoggedStateEntries], fmt.Sprintf("... %d more entries", len(state)-maxLoggedStateEntries))
+				changed = true
+				continue
+			}
+			if truncateStateArrays(value) {
+				changed = true
+			}
+		}
+	case []any:
+		for _, elem := range v {
+			if truncateStateArrays(elem) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
@@ -0,0 +1,108 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestClientLogsRequestsWithoutToken(t *testing.T) {
+	const token = "s3cr3t-token"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		state := make([]int64, 1000)
+		_ = json.NewEncoder(w).Encode(PoolDetail{ID: "pool-1", Begin: "10.0.0.1", End: "10.0.3.232", State: state})
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	c, err := NewClient(server.URL, token, nil)
+	if err != nil {
+		t.Fatalf("NewClient: %v", err)
+	}
+	if _, err := c.GetPoolByID(ctx, "pool-1"); err != nil {
+		t.Fatalf("GetPoolByID: %v", err)
+	}
+
+	if strings.Contains(output.String(), token) {
+		t.Fatalf("token leaked into logs:\n%s", output.String())
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("decode logs: %v", err)
+	}
+
+	messages := map[string]map[string]any{}
+	for _, entry := range entries {
+		if entry["@module"] != "provider."+LogSubsystem {
+			continue
+		}
+		msg, _ := entry["@message"].(string)
+		messages[msg] = entry
+	}
+
+	request, ok := messages["Sending Zeus API request"]
+	if !ok {
+		t.Fatalf("missing request log, got %v", entries)
+	}
+	if request["@level"] != "debug" || request["http_method"] != http.MethodGet {
+		t.Fatalf("unexpected request log: %v", request)
+	}
+	headers, _ := request["http_headers"].(map[string]any)
+	if headers["Authorization"] != redacted {
+		t.Fatalf("expected a redacted Authorization header, got %v", headers)
+	}
+
+	response, ok := messages["Received Zeus API response"]
+	if !ok {
+		t.Fatalf("missing response log, got %v", entries)
+	}
+	if response["http_status_code"] != float64(http.StatusOK) {
+		t.Fatalf("unexpected response log: %v", response)
+	}
+	if _, ok := response["duration_ms"]; !ok {
+		t.Fatalf("response log has no duration: %v", response)
+	}
+
+	body, ok := messages["Zeus API response body"]
+	if !ok || body["@level"] != "trace" {
+		t.Fatalf("missing trace body log, got %v", entries)
+	}
+	if logged, _ := body["http_body"].(string); !strings.Contains(logged, "936 more entries") {
+		t.Fatalf("expected the state array to be truncated, got %s", logged)
+	}
+}
+
+func TestLoggableBody(t *testing.T) {
+	state := make([]int, maxLoggedStateEntries+2)
+	raw, err := json.Marshal(map[string]any{"id": "pool-1", "state": state, "note": "<a>"})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	got := loggableBody(raw)
+	if !strings.Contains(got, `"... 2 more entries"`) || !strings.Contains(got, `"<a>"`) {
+		t.Fatalf("unexpected body: %s", got)
+	}
+
+	short := `{"state":[0,1,2]}`
+	if got := loggableBody([]byte(short)); got != short {
+		t.Fatalf("expected short bodies to be logged as is, got %s", got)
+	}
+
+	if got := loggableBody(bytes.Repeat([]byte("x"), maxLoggedBodyBytes+10)); !strings.HasSuffix(got, "... (10 more bytes)") {
+		t.Fatalf("expected the body to be capped, got suffix %q", got[len(got)-30:])
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// healthPath is the only endpoint that does not need a token, so the health
+// check still works when the token source is broken.
+const healthPath = "/_internal/health"
+
+func requiresToken(path string) bool {
+	return path != healthPath
+}
+
+// TokenError is returned when the token source fails, before any request is
+// sent.
+type TokenError struct {
+	Err error
+}
+
+func (e *TokenError) Error() string {
+	return "get token: " + e.Err.Error()
+}
+
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// TokenSource supplies the bearer token sent with every request.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+type staticTokenSource string
+
+// StaticTokenSource always returns token.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+type fileTokenSource string
+
+// FileTokenSource reads the token from the file at path, for example one
+// kept up to date by a credential agent. Surrounding whitespace is ignored.
+func FileTokenSource(path string) TokenSource {
+	return fileTokenSource(path)
+}
+
+func (s fileTokenSource) Token(ctx context.Context) (string, error) {
+	b, err := os.ReadFile(string(s))
+	if err != nil {
+		return "", fmt.Errorf("read token file: %w", err)
+	}
+	token := strings.TrimSpace(string(b))
+	if token == "" {
+		return "", fmt.Errorf("token file %s is empty", string(s))
+	}
+	return token, nil
+}
+
+type commandTokenSource []string
+
+// CommandTokenSource runs argv and uses its standard output as the token,
+// like an exec credential plugin.
+func CommandTokenSource(argv []string) TokenSource {
+	return commandTokenSource(argv)
+}
+
+func (s commandTokenSource) Token(ctx context.Context) (string, error) {
+	if len(s) == 0 {
+		return "", errors.New("token command is empty")
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, s[0], s[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("run token command %q: %w: %s", s[0], err, msg)
+		}
+		return "", fmt.Errorf("run token command %q: %w", s[0], err)
+	}
+
+	token := strings.TrimSpace(stdout.String())
+	if token == "" {
+		return "", fmt.Errorf("token command %q printed no token", s[0])
+	}
+	return token, nil
+}
+
+// tokenCache fetches the token on first use and keeps it until a request is
+// rejected with it.
+type tokenCache struct {
+	source TokenSource
+
+	mu    sync.Mutex
+	token string
+	valid bool
+}
+
+func (c *tokenCache) get(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.valid {
+		return c.token, nil
+	}
+	token, err := c.source.Token(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.token, c.valid = token, true
+	return token, nil
+}
+
+// invalidate drops the cached token if it is still the one that was rejected,
+// so concurrent requests failing with the same token only refetch once.
+func (c *tokenCache) invalidate(rejected string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.valid && c.token == rejected {
+		c.valid = false
+	}
+}
+
+// refreshable reports whether fetching the token again may return a
+// different one.
+func (c *tokenCache) refreshable() bool {
+	_, static := c.source.(staticTokenSource)
+	return !static
+}
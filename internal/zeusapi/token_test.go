@@ -0,0 +1,200 @@
+// Copyright WANIX Inc. 2025, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package zeusapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+type countingTokenSource struct {
+	calls atomic.Int32
+}
+
+func (s *countingTokenSource) Token(ctx context.Context) (string, error) {
+	return fmt.Sprintf("token-%d", s.calls.Add(1)), nil
+}
+
+func TestFileTokenSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(path, []byte("  secret\n"), 0o600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+
+	token, err := FileTokenSource(path).Token(context.Background())
+	if err != nil || token != "secret" {
+		t.Fatalf("Token() = %q, %v; want \"secret\"", token, err)
+	}
+
+	if err := os.WriteFile(path, []byte("\n"), 0o600); err != nil {
+		t.Fatalf("write token: %v", err)
+	}
+	if _, err := FileTokenSource(path).Token(context.Background()); err == nil {
+		t.Fatal("expected error for empty token file")
+	}
+
+	if _, err := FileTokenSource(filepath.Join(t.TempDir(), "missing")).Token(context.Background()); !errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("expected not exist error, got %v", err)
+	}
+}
+
+func TestCommandTokenSource(t *testing.T) {
+	if _, err := os.Stat("/bin/sh"); err != nil {
+		t.Skip("skip: /bin/sh not available")
+	}
+
+	token, err := CommandTokenSource([]string{"/bin/sh", "-c", "echo minted"}).Token(context.Background())
+	if err != nil || token != "minted" {
+		t.Fatalf("Token() = %q, %v; want \"minted\"", token, err)
+	}
+
+	_, err = CommandTokenSource([]string{"/bin/sh", "-c", "echo vault sealed >&2; exit 3"}).Token(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "vault sealed") {
+		t.Fatalf("expected error with stderr, got %v", err)
+	}
+
+	if _, err := CommandTokenSource([]string{"/bin/sh", "-c", "true"}).Token(context.Background()); err == nil {
+		t.Fatal("expected error when no token is printed")
+	}
+
+	if _, err := CommandTokenSource(nil).Token(context.Background()); err == nil {
+		t.Fatal("expected error for empty command")
+	}
+}
+
+func TestClientFetchesTokenLazilyAndCaches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{}
+	c := newTestClient(t, server.URL, nil, WithTokenSource(source))
+	if got := source.calls.Load(); got != 0 {
+		t.Fatalf("expected no token fetch before the first request, got %d", got)
+	}
+
+	for range 3 {
+		if _, err := c.ListRegions(context.Background()); err != nil {
+			t.Fatalf("ListRegions: %v", err)
+		}
+	}
+	if got := source.calls.Load(); got != 1 {
+		t.Fatalf("expected the token to be fetched once, got %d", got)
+	}
+}
+
+func TestClientRefreshesTokenOnceOnUnauthorized(t *testing.T) {
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(b))
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"region-1"}`))
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{}
+	c := newTestClient(t, server.URL, nil, WithTokenSource(source), WithMaxRetries(0))
+
+	// Prime the cache with token-1, which the server now rejects.
+	if _, err := c.tokens.get(context.Background()); err != nil {
+		t.Fatalf("prime token: %v", err)
+	}
+
+	resp, err := c.CreateRegion(context.Background(), CreateRegionRequest{Name: "us-east-1"})
+	if err != nil {
+		t.Fatalf("CreateRegion: %v", err)
+	}
+	if resp.ID != "region-1" {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] {
+		t.Fatalf("expected the same body to be sent twice, got %q", bodies)
+	}
+}
+
+func TestClientGivesUpAfterOneTokenRefresh(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	source := &countingTokenSource{}
+	c := newTestClient(t, server.URL, nil, WithTokenSource(source))
+
+	_, err := c.ListRegions(context.Background())
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("expected 401 APIError, got %v", err)
+	}
+	if got := attempts.Load(); got != 2 {
+		t.Fatalf("expected 2 attempts, got %d", got)
+	}
+	if got := source.calls.Load(); got != 2 {
+		t.Fatalf("expected 2 token fetches, got %d", got)
+	}
+}
+
+func TestClientDoesNotRefreshStaticToken(t *testing.T) {
+	var attempts atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil)
+	if _, err := c.ListRegions(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if got := attempts.Load(); got != 1 {
+		t.Fatalf("expected 1 attempt, got %d", got)
+	}
+}
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token(ctx context.Context) (string, error) {
+	return "", errors.New("vault sealed")
+}
+
+func TestClientHealthDoesNotNeedToken(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "" {
+			t.Errorf("unexpected Authorization header on %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"message":"ok"}`))
+	}))
+	defer server.Close()
+
+	c := newTestClient(t, server.URL, nil, WithTokenSource(failingTokenSource{}))
+	if _, err := c.Health(context.Background()); err != nil {
+		t.Fatalf("Health: %v", err)
+	}
+
+	_, err := c.CheckConnection(context.Background())
+	var tokenErr *TokenError
+	if !errors.As(err, &tokenErr) || tokenErr.Err.Error() != "vault sealed" {
+		t.Fatalf("expected a TokenError, got %v", err)
+	}
+}